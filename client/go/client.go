@@ -4,20 +4,45 @@ import (
   "context"
   "errors"
   pb "github.com/pantonshire/goldcrest/protocol"
+  "google.golang.org/genproto/googleapis/rpc/errdetails"
   "google.golang.org/grpc"
   "google.golang.org/grpc/metadata"
+  "google.golang.org/grpc/status"
+  "io"
   "strconv"
   "time"
 )
 
+const (
+  // Chunks must be no larger than 5MB for Twitter and 4MB for the default gRPC message size limit
+  mediaChunkSize = 1 << 20
+)
+
 type Client struct {
-  twitter pb.TwitterClient
-  auth    authentication
-  timeout time.Duration
-  retry   retryPolicy
-  twopts  TweetOptions
+  twitter  pb.TwitterClient
+  ctx      context.Context
+  auth     authentication
+  timeout  time.Duration
+  retry    retryPolicy
+  twopts   TweetOptions
+  wait     bool
+  priority Priority
+  apiKey   string
+  // Whether to ask the proxy to report errors as gRPC statuses
+  statusErrors bool
 }
 
+// How the proxy should treat a request when the rate limit is close to running out.
+type Priority string
+
+const (
+  // Requests made in response to a user, which may use the whole rate limit.
+  Interactive Priority = "interactive"
+  // Bulk requests, which may not use the part of the rate limit that the proxy holds back for interactive
+  // requests.
+  Background Priority = "background"
+)
+
 func NewClient(conn *grpc.ClientConn) Client {
   return Client{
     twitter: pb.NewTwitterClient(conn),
@@ -35,6 +60,13 @@ func (client Client) WithAuth(consumerKey, accessToken, secretKey, secretToken s
   return client
 }
 
+// Makes requests with ctx, so that cancelling it cancels any requests in progress, including any waiting
+// between retries.
+func (client Client) WithContext(ctx context.Context) Client {
+  client.ctx = ctx
+  return client
+}
+
 func (client Client) WithTimeout(timeout time.Duration) Client {
   client.timeout = timeout
   return client
@@ -54,6 +86,31 @@ func (client Client) WithRetryTimeout(timeout time.Duration) Client {
   return client
 }
 
+// When enabled, the proxy holds on to requests which hit the rate limit until it resets rather than responding
+// with a rate limit error. Use WithTimeout to bound how long a request may wait.
+func (client Client) WithWaitForLimit(wait bool) Client {
+  client.wait = wait
+  return client
+}
+
+// Identifies the client to the proxy, which may limit how much of each rate limit it can use.
+func (client Client) WithAPIKey(apiKey string) Client {
+  client.apiKey = apiKey
+  return client
+}
+
+func (client Client) WithPriority(priority Priority) Client {
+  client.priority = priority
+  return client
+}
+
+// When enabled, the proxy reports errors as gRPC statuses rather than in its responses, so that they are visible
+// to interceptors and other gRPC tooling. The errors returned by the client are the same either way.
+func (client Client) WithStatusErrors(statusErrors bool) Client {
+  client.statusErrors = statusErrors
+  return client
+}
+
 func (client Client) WithTweetOptions(twopts TweetOptions) Client {
   client.twopts = twopts
   return client
@@ -111,11 +168,37 @@ func (r timeoutRetryer) shouldRetry(t time.Time) bool {
   return t.Before(r.deadline)
 }
 
+// Returns a context carrying the metadata which tells the proxy how to handle the client's requests.
+func (client Client) outgoingContext() context.Context {
+  ctx := client.context()
+  if client.wait {
+    ctx = metadata.AppendToOutgoingContext(ctx, "goldcrest-wait", "true")
+  }
+  if client.priority != "" {
+    ctx = metadata.AppendToOutgoingContext(ctx, "goldcrest-priority", string(client.priority))
+  }
+  if client.apiKey != "" {
+    ctx = metadata.AppendToOutgoingContext(ctx, "goldcrest-api-key", client.apiKey)
+  }
+  if client.statusErrors {
+    ctx = metadata.AppendToOutgoingContext(ctx, "goldcrest-errors", "status")
+  }
+  return ctx
+}
+
+func (client Client) context() context.Context {
+  if client.ctx == nil {
+    return context.Background()
+  }
+  return client.ctx
+}
+
 func (client Client) newContext() (context.Context, context.CancelFunc) {
+  ctx := client.outgoingContext()
   if client.timeout > 0 {
-    return context.WithTimeout(context.Background(), client.timeout)
+    return context.WithTimeout(ctx, client.timeout)
   }
-  return context.Background(), nil
+  return ctx, nil
 }
 
 func (client Client) request(reqFunc func(ctx context.Context) (metadata.MD, *pb.Error, error)) error {
@@ -129,33 +212,112 @@ func (client Client) request(reqFunc func(ctx context.Context) (metadata.MD, *pb
       return reqFunc(ctx)
     }()
     if err != nil {
-      return err
+      var ok bool
+      if errMsg, meta, ok = desStatus(err); !ok {
+        return err
+      }
     }
     if errMsg != nil {
-      if errMsg.Code == pb.Error_RATE_LIMIT {
-        if meta != nil {
-          if retryStrs := meta.Get("retry"); len(retryStrs) > 0 {
-            retryUnix, err := strconv.ParseInt(retryStrs[0], 10, 64)
-            if err != nil {
-              return err
-            }
-            retryTime := time.Unix(retryUnix, 0)
-            if rp == nil || rp.shouldRetry(retryTime) {
-              time.Sleep(retryTime.Sub(time.Now())) //TODO: use a context timeout here
-              continue
-            }
-            return RateLimitError{resets: retryTime}
-          }
+      err := desErrorMsg(errMsg, meta)
+      if rlErr, ok := err.(RateLimitError); ok && (rp == nil || rp.shouldRetry(rlErr.resets)) {
+        if err := sleepContext(client.context(), time.Until(rlErr.resets)); err != nil {
+          return err
         }
-        return AmbiguousRateLimitError{}
-      } else {
-        return errors.New(errMsg.Message) //TODO: wrap in custom error type
+        continue
       }
+      return err
     }
     return nil
   }
 }
 
+func sleepContext(ctx context.Context, d time.Duration) error {
+  timer := time.NewTimer(d)
+  defer timer.Stop()
+  select {
+  case <-timer.C:
+    return nil
+  case <-ctx.Done():
+    return ctx.Err()
+  }
+}
+
+// Extracts the Error from a status returned by a proxy which reports errors as statuses. The reset time from
+// its RetryInfo is returned in the retry metadata, where it would be if the error had been in the response.
+func desStatus(err error) (*pb.Error, metadata.MD, bool) {
+  st, ok := status.FromError(err)
+  if !ok {
+    return nil, nil, false
+  }
+  var (
+    errMsg *pb.Error
+    meta   metadata.MD
+  )
+  for _, detail := range st.Details() {
+    switch detail := detail.(type) {
+    case *pb.Error:
+      errMsg = detail
+    case *errdetails.RetryInfo:
+      resets := time.Now().Add(detail.GetRetryDelay().AsDuration())
+      meta = metadata.Pairs("retry", strconv.FormatInt(resets.Unix(), 10))
+    }
+  }
+  return errMsg, meta, errMsg != nil
+}
+
+// Converts an error received from a stream, which is either a status or an Error sent as the last message.
+func desStreamError(errMsg *pb.Error, err error) error {
+  if errMsg == nil {
+    var ok bool
+    if errMsg, _, ok = desStatus(err); !ok {
+      return err
+    }
+  }
+  return desErrorMsg(errMsg, nil)
+}
+
+func desErrorMsg(errMsg *pb.Error, meta metadata.MD) error {
+  if errMsg.Code == pb.Error_RATE_LIMIT {
+    if meta != nil {
+      if retryStrs := meta.Get("retry"); len(retryStrs) > 0 {
+        retryUnix, err := strconv.ParseInt(retryStrs[0], 10, 64)
+        if err != nil {
+          return err
+        }
+        return RateLimitError{resets: time.Unix(retryUnix, 0)}
+      }
+    }
+    return AmbiguousRateLimitError{}
+  }
+  if errMsg.Code == pb.Error_QUOTA_EXCEEDED {
+    err := QuotaExceededError{message: errMsg.Message}
+    if retryStrs := meta.Get("retry"); len(retryStrs) > 0 {
+      if retryUnix, parseErr := strconv.ParseInt(retryStrs[0], 10, 64); parseErr == nil {
+        err.resets = time.Unix(retryUnix, 0)
+      }
+    }
+    return err
+  }
+  twitterErr := TwitterError{
+    message:     errMsg.Message,
+    twitterCode: int(errMsg.TwitterCode),
+    httpStatus:  int(errMsg.HttpStatus),
+  }
+  switch errMsg.Code {
+  case pb.Error_NOT_FOUND:
+    return NotFoundError{twitterErr}
+  case pb.Error_UNAUTHORIZED:
+    return UnauthorizedError{twitterErr}
+  case pb.Error_FORBIDDEN:
+    return ForbiddenError{twitterErr}
+  case pb.Error_DUPLICATE:
+    return DuplicateError{twitterErr}
+  case pb.Error_TWITTER_ERROR, pb.Error_BAD_REQUEST:
+    return twitterErr
+  }
+  return errors.New(errMsg.Message)
+}
+
 func (client Client) tweetRequest(id uint64, grpcFunc func(context.Context, *pb.TweetRequest, ...grpc.CallOption) (*pb.TweetResponse, error)) (Tweet, error) {
   var msg *pb.Tweet
   err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
@@ -320,8 +482,10 @@ func (client Client) UserTimeline(user UserIdentifier, tlopts TimelineOptions, r
   return desTimeline(msg), nil
 }
 
-func (client Client) SearchTweets(searchOpts SearchOptions, tlOpts TimelineOptions) ([]Tweet, error) {
-  var msg *pb.Tweets
+// Returns a page of search results. The result's Next and Refresh methods fetch the next page of older results
+// and any newer results with the same options.
+func (client Client) SearchTweets(searchOpts SearchOptions, tlOpts TimelineOptions) (SearchResult, error) {
+  var msg *pb.SearchResult
   err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
     var header metadata.MD
     resp, err := client.twitter.SearchTweets(
@@ -331,19 +495,23 @@ func (client Client) SearchTweets(searchOpts SearchOptions, tlOpts TimelineOptio
     if err != nil {
       return nil, nil, err
     }
-    if success, ok := resp.Response.(*pb.TweetsResponse_Tweets); ok {
-      msg = success.Tweets
+    if success, ok := resp.Response.(*pb.SearchResponse_Result); ok {
+      msg = success.Result
       return header, nil, nil
-    } else if failure, ok := resp.Response.(*pb.TweetsResponse_Error); ok {
+    } else if failure, ok := resp.Response.(*pb.SearchResponse_Error); ok {
       return header, failure.Error, nil
     } else {
       return header, nil, errors.New("invalid response")
     }
   })
   if err != nil {
-    return nil, err
+    return SearchResult{}, err
   }
-  return desTimeline(msg), nil
+  result := desSearchResult(msg)
+  result.client = client
+  result.searchOpts = searchOpts
+  result.tlOpts = tlOpts
+  return result, nil
 }
 
 func (client Client) PublishTweet(com TweetComposer) (Tweet, error) {
@@ -391,3 +559,646 @@ func (client Client) UpdateProfile(pu ProfileUpdater, includeEntities, includeSt
   }
   return desUser(msg), nil
 }
+
+func (client Client) GetUser(user UserIdentifier) (User, error) {
+  var msg *pb.User
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    req := &pb.UserRequest{
+      Auth:            client.auth.ser(),
+      IncludeEntities: client.twopts.includeEntities,
+    }
+    user.serIntoUserRequest(req)
+    resp, err := client.twitter.GetUser(ctx, req, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.UserResponse_User); ok {
+      msg = success.User
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.UserResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return User{}, err
+  }
+  return desUser(msg), nil
+}
+
+// Gets up to 100 users at once.
+func (client Client) GetUsers(users ...UserIdentifier) ([]User, error) {
+  if len(users) == 0 {
+    return nil, nil
+  }
+  var msg *pb.Users
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    req := &pb.UsersRequest{
+      Auth:            client.auth.ser(),
+      IncludeEntities: client.twopts.includeEntities,
+    }
+    for _, user := range users {
+      user.serIntoUsersRequest(req)
+    }
+    resp, err := client.twitter.GetUsers(ctx, req, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.UsersResponse_Users); ok {
+      msg = success.Users
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.UsersResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return nil, err
+  }
+  return desUsers(msg), nil
+}
+
+func (client Client) GetRaw(method, path string, query, body map[string]string) (RawResponse, error) {
+  var msg *pb.RawAPIResult
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.GetRaw(ctx, &pb.RawAPIRequest{
+      Auth:        client.auth.ser(),
+      Method:      method,
+      Path:        path,
+      QueryParams: query,
+      BodyParams:  body,
+    }, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.RawAPIResponse_Result); ok {
+      msg = success.Result
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.RawAPIResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return RawResponse{}, err
+  }
+  return desRawResponse(msg), nil
+}
+
+// Returns what the proxy currently knows about each of the rate limits for the client's access token.
+func (client Client) GetRateLimits() ([]RateLimit, error) {
+  var msg *pb.RateLimits
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.GetRateLimits(ctx, &pb.RateLimitsRequest{
+      Auth: client.auth.ser(),
+    }, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.RateLimitsResponse_Limits); ok {
+      msg = success.Limits
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.RateLimitsResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return nil, err
+  }
+  return desRateLimits(msg), nil
+}
+
+func (client Client) StreamSearch(searchOpts SearchOptions, tlOpts TimelineOptions, minInterval time.Duration) (TweetStream, error) {
+  return client.streamTweets(&pb.StreamTweetsRequest{
+    Source: &pb.StreamTweetsRequest_Search{
+      Search: serSearchRequest(client.auth, searchOpts, client.twopts, tlOpts),
+    },
+    MinIntervalSeconds: uint32(minInterval / time.Second),
+  })
+}
+
+func (client Client) StreamMentionTimeline(tlOpts TimelineOptions, minInterval time.Duration) (TweetStream, error) {
+  return client.streamTweets(&pb.StreamTweetsRequest{
+    Source: &pb.StreamTweetsRequest_MentionTimeline{
+      MentionTimeline: &pb.MentionTimelineRequest{
+        Auth:            client.auth.ser(),
+        TimelineOptions: tlOpts.ser(client.twopts),
+      },
+    },
+    MinIntervalSeconds: uint32(minInterval / time.Second),
+  })
+}
+
+func (client Client) StreamUserTimeline(user UserIdentifier, tlOpts TimelineOptions, replies, retweets bool, minInterval time.Duration) (TweetStream, error) {
+  req := &pb.UserTimelineRequest{
+    Auth:            client.auth.ser(),
+    TimelineOptions: tlOpts.ser(client.twopts),
+    IncludeReplies:  replies,
+    IncludeRetweets: retweets,
+  }
+  user.serIntoUserTimelineRequest(req)
+  return client.streamTweets(&pb.StreamTweetsRequest{
+    Source:             &pb.StreamTweetsRequest_UserTimeline{UserTimeline: req},
+    MinIntervalSeconds: uint32(minInterval / time.Second),
+  })
+}
+
+func (client Client) streamTweets(req *pb.StreamTweetsRequest) (TweetStream, error) {
+  ctx, cancel := context.WithCancel(client.outgoingContext())
+  stream, err := client.twitter.StreamTweets(ctx, req)
+  if err != nil {
+    cancel()
+    return TweetStream{}, err
+  }
+  return TweetStream{stream: stream, cancel: cancel}, nil
+}
+
+func (client Client) FollowerIDs(user UserIdentifier, cursorOpts CursorOptions) (UserIDPage, error) {
+  return client.userIDPage(client.twitter.GetFollowerIds, user, cursorOpts)
+}
+
+func (client Client) FollowingIDs(user UserIdentifier, cursorOpts CursorOptions) (UserIDPage, error) {
+  return client.userIDPage(client.twitter.GetFollowingIds, user, cursorOpts)
+}
+
+func (client Client) Followers(user UserIdentifier, cursorOpts CursorOptions, includeStatuses bool) (UserPage, error) {
+  return client.userPage(client.twitter.GetFollowers, user, cursorOpts, includeStatuses)
+}
+
+func (client Client) Following(user UserIdentifier, cursorOpts CursorOptions, includeStatuses bool) (UserPage, error) {
+  return client.userPage(client.twitter.GetFollowing, user, cursorOpts, includeStatuses)
+}
+
+func (client Client) IterFollowerIDs(user UserIdentifier, cursorOpts CursorOptions) (UserIDIterator, error) {
+  ctx, cancel := context.WithCancel(client.outgoingContext())
+  stream, err := client.twitter.StreamFollowerIds(ctx, client.serUserGraphRequest(user, cursorOpts, false))
+  if err != nil {
+    cancel()
+    return UserIDIterator{}, err
+  }
+  return UserIDIterator{stream: stream, cancel: cancel}, nil
+}
+
+func (client Client) IterFollowingIDs(user UserIdentifier, cursorOpts CursorOptions) (UserIDIterator, error) {
+  ctx, cancel := context.WithCancel(client.outgoingContext())
+  stream, err := client.twitter.StreamFollowingIds(ctx, client.serUserGraphRequest(user, cursorOpts, false))
+  if err != nil {
+    cancel()
+    return UserIDIterator{}, err
+  }
+  return UserIDIterator{stream: stream, cancel: cancel}, nil
+}
+
+func (client Client) IterFollowers(user UserIdentifier, cursorOpts CursorOptions, includeStatuses bool) (UserIterator, error) {
+  ctx, cancel := context.WithCancel(client.outgoingContext())
+  stream, err := client.twitter.StreamFollowers(ctx, client.serUserGraphRequest(user, cursorOpts, includeStatuses))
+  if err != nil {
+    cancel()
+    return UserIterator{}, err
+  }
+  return UserIterator{stream: stream, cancel: cancel}, nil
+}
+
+func (client Client) IterFollowing(user UserIdentifier, cursorOpts CursorOptions, includeStatuses bool) (UserIterator, error) {
+  ctx, cancel := context.WithCancel(client.outgoingContext())
+  stream, err := client.twitter.StreamFollowing(ctx, client.serUserGraphRequest(user, cursorOpts, includeStatuses))
+  if err != nil {
+    cancel()
+    return UserIterator{}, err
+  }
+  return UserIterator{stream: stream, cancel: cancel}, nil
+}
+
+type userIDPageRPC func(ctx context.Context, in *pb.UserGraphRequest, opts ...grpc.CallOption) (*pb.UserIdsResponse, error)
+
+type userPageRPC func(ctx context.Context, in *pb.UserGraphRequest, opts ...grpc.CallOption) (*pb.UserPageResponse, error)
+
+func (client Client) userIDPage(rpc userIDPageRPC, user UserIdentifier, cursorOpts CursorOptions) (UserIDPage, error) {
+  var msg *pb.UserIdPage
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := rpc(ctx, client.serUserGraphRequest(user, cursorOpts, false), grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.UserIdsResponse_Page); ok {
+      msg = success.Page
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.UserIdsResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return UserIDPage{}, err
+  }
+  return desUserIDPage(msg), nil
+}
+
+func (client Client) userPage(rpc userPageRPC, user UserIdentifier, cursorOpts CursorOptions, includeStatuses bool) (UserPage, error) {
+  var msg *pb.UserPage
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := rpc(ctx, client.serUserGraphRequest(user, cursorOpts, includeStatuses), grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.UserPageResponse_Page); ok {
+      msg = success.Page
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.UserPageResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return UserPage{}, err
+  }
+  return desUserPage(msg), nil
+}
+
+func (client Client) serUserGraphRequest(user UserIdentifier, cursorOpts CursorOptions, includeStatuses bool) *pb.UserGraphRequest {
+  req := &pb.UserGraphRequest{
+    Auth:            client.auth.ser(),
+    CursorOptions:   cursorOpts.ser(),
+    IncludeStatuses: includeStatuses,
+    IncludeEntities: client.twopts.includeEntities,
+  }
+  user.serIntoUserGraphRequest(req)
+  return req
+}
+
+func (client Client) FollowUser(user UserIdentifier) (Relationship, error) {
+  return client.relationship(client.twitter.FollowUser, user)
+}
+
+func (client Client) UnfollowUser(user UserIdentifier) (Relationship, error) {
+  return client.relationship(client.twitter.UnfollowUser, user)
+}
+
+func (client Client) BlockUser(user UserIdentifier) (Relationship, error) {
+  return client.relationship(client.twitter.BlockUser, user)
+}
+
+func (client Client) UnblockUser(user UserIdentifier) (Relationship, error) {
+  return client.relationship(client.twitter.UnblockUser, user)
+}
+
+func (client Client) MuteUser(user UserIdentifier) (Relationship, error) {
+  return client.relationship(client.twitter.MuteUser, user)
+}
+
+func (client Client) UnmuteUser(user UserIdentifier) (Relationship, error) {
+  return client.relationship(client.twitter.UnmuteUser, user)
+}
+
+func (client Client) GetRelationship(user UserIdentifier) (Relationship, error) {
+  return client.relationship(client.twitter.GetRelationship, user)
+}
+
+type relationshipRPC func(ctx context.Context, in *pb.UserRequest, opts ...grpc.CallOption) (*pb.RelationshipResponse, error)
+
+func (client Client) relationship(rpc relationshipRPC, user UserIdentifier) (Relationship, error) {
+  var msg *pb.Relationship
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    req := &pb.UserRequest{
+      Auth:            client.auth.ser(),
+      IncludeEntities: client.twopts.includeEntities,
+    }
+    user.serIntoUserRequest(req)
+    resp, err := rpc(ctx, req, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.RelationshipResponse_Relationship); ok {
+      msg = success.Relationship
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.RelationshipResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return Relationship{}, err
+  }
+  return desRelationship(msg), nil
+}
+
+func (client Client) SendDirectMessage(com DirectMessageComposer) (DirectMessage, error) {
+  var msg *pb.DirectMessage
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.SendDirectMessage(ctx, com.ser(client.auth), grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.DirectMessageResponse_Message); ok {
+      msg = success.Message
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.DirectMessageResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return DirectMessage{}, err
+  }
+  return desDirectMessage(msg), nil
+}
+
+// Gets a page of the direct messages sent and received in the last 30 days, newest first. An empty cursor
+// gets the first page.
+func (client Client) ListDirectMessages(count uint, cursor string) (DirectMessagePage, error) {
+  var msg *pb.DirectMessagePage
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.ListDirectMessages(ctx, &pb.ListDirectMessagesRequest{
+      Auth:   client.auth.ser(),
+      Count:  uint32(count),
+      Cursor: cursor,
+    }, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.DirectMessagesResponse_Page); ok {
+      msg = success.Page
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.DirectMessagesResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return DirectMessagePage{}, err
+  }
+  return desDirectMessagePage(msg), nil
+}
+
+func (client Client) GetList(listID uint64) (List, error) {
+  var msg *pb.List
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.GetList(ctx, &pb.ListRequest{
+      Auth:   client.auth.ser(),
+      ListId: listID,
+    }, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.ListResponse_List); ok {
+      msg = success.List
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.ListResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return List{}, err
+  }
+  return desList(msg), nil
+}
+
+func (client Client) ListTimeline(listID uint64, tlopts TimelineOptions, retweets bool) ([]Tweet, error) {
+  var msg *pb.Tweets
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.GetListTimeline(ctx, &pb.ListTimelineRequest{
+      Auth:            client.auth.ser(),
+      ListId:          listID,
+      TimelineOptions: tlopts.ser(client.twopts),
+      IncludeRetweets: retweets,
+    }, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.TweetsResponse_Tweets); ok {
+      msg = success.Tweets
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.TweetsResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return nil, err
+  }
+  return desTimeline(msg), nil
+}
+
+func (client Client) ListMembers(listID uint64, cursorOpts CursorOptions, includeStatuses bool) (UserPage, error) {
+  var msg *pb.UserPage
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.GetListMembers(ctx, &pb.ListMembersRequest{
+      Auth:            client.auth.ser(),
+      ListId:          listID,
+      CursorOptions:   cursorOpts.ser(),
+      IncludeStatuses: includeStatuses,
+      IncludeEntities: client.twopts.includeEntities,
+    }, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.UserPageResponse_Page); ok {
+      msg = success.Page
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.UserPageResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return UserPage{}, err
+  }
+  return desUserPage(msg), nil
+}
+
+func (client Client) AddListMember(listID uint64, user UserIdentifier) (List, error) {
+  return client.changeListMember(client.twitter.AddListMember, listID, user)
+}
+
+func (client Client) RemoveListMember(listID uint64, user UserIdentifier) (List, error) {
+  return client.changeListMember(client.twitter.RemoveListMember, listID, user)
+}
+
+type listMemberRPC func(ctx context.Context, in *pb.ListMemberRequest, opts ...grpc.CallOption) (*pb.ListResponse, error)
+
+func (client Client) changeListMember(rpc listMemberRPC, listID uint64, user UserIdentifier) (List, error) {
+  var msg *pb.List
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    req := &pb.ListMemberRequest{
+      Auth:   client.auth.ser(),
+      ListId: listID,
+    }
+    user.serIntoListMemberRequest(req)
+    resp, err := rpc(ctx, req, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.ListResponse_List); ok {
+      msg = success.List
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.ListResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return List{}, err
+  }
+  return desList(msg), nil
+}
+
+// Gets a page of the lists owned by the given user. If user is nil, the authenticated user's lists are
+// returned.
+func (client Client) OwnedLists(user UserIdentifier, cursorOpts CursorOptions) (ListPage, error) {
+  var msg *pb.ListPage
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    req := &pb.OwnedListsRequest{
+      Auth:          client.auth.ser(),
+      CursorOptions: cursorOpts.ser(),
+    }
+    if user != nil {
+      user.serIntoOwnedListsRequest(req)
+    }
+    resp, err := client.twitter.GetOwnedLists(ctx, req, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.ListPageResponse_Page); ok {
+      msg = success.Page
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.ListPageResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return ListPage{}, err
+  }
+  return desListPage(msg), nil
+}
+
+func (client Client) UploadMedia(media []byte, category string) (UploadedMedia, error) {
+  var msg *pb.UploadedMedia
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.UploadMedia(ctx, &pb.UploadMediaRequest{
+      Auth:          client.auth.ser(),
+      Media:         media,
+      MediaCategory: category,
+    }, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.MediaResponse_Media); ok {
+      msg = success.Media
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.MediaResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return UploadedMedia{}, err
+  }
+  return desUploadedMedia(msg), nil
+}
+
+// Uploads the media read from r in chunks, which is required for videos and GIFs. Since r cannot be read
+// twice, the upload is not retried if the rate limit is hit.
+func (client Client) UploadMediaChunked(r io.Reader, totalBytes uint64, mediaType, category string, waitForProcessing bool) (UploadedMedia, error) {
+  ctx, cancel := client.newContext()
+  if cancel != nil {
+    defer cancel()
+  }
+  var header metadata.MD
+  stream, err := client.twitter.UploadMediaChunked(ctx, grpc.Header(&header))
+  if err != nil {
+    return UploadedMedia{}, err
+  }
+  if err := stream.Send(&pb.MediaChunk{Chunk: &pb.MediaChunk_Init_{Init: &pb.MediaChunk_Init{
+    Auth:              client.auth.ser(),
+    TotalBytes:        totalBytes,
+    MediaType:         mediaType,
+    MediaCategory:     category,
+    WaitForProcessing: waitForProcessing,
+  }}}); err != nil {
+    return UploadedMedia{}, err
+  }
+  buf := make([]byte, mediaChunkSize)
+  for {
+    n, err := io.ReadFull(r, buf)
+    if n > 0 {
+      if err := stream.Send(&pb.MediaChunk{Chunk: &pb.MediaChunk_Data{Data: buf[:n]}}); err != nil {
+        return UploadedMedia{}, err
+      }
+    }
+    if err == io.EOF || err == io.ErrUnexpectedEOF {
+      break
+    } else if err != nil {
+      return UploadedMedia{}, err
+    }
+  }
+  resp, err := stream.CloseAndRecv()
+  if err != nil {
+    return UploadedMedia{}, err
+  }
+  if success, ok := resp.Response.(*pb.MediaResponse_Media); ok {
+    return desUploadedMedia(success.Media), nil
+  } else if failure, ok := resp.Response.(*pb.MediaResponse_Error); ok {
+    return UploadedMedia{}, desErrorMsg(failure.Error, header)
+  } else {
+    return UploadedMedia{}, errors.New("invalid response")
+  }
+}
+
+func (client Client) GetMediaStatus(id uint64) (UploadedMedia, error) {
+  var msg *pb.UploadedMedia
+  err := client.request(func(ctx context.Context) (metadata.MD, *pb.Error, error) {
+    var header metadata.MD
+    resp, err := client.twitter.GetMediaStatus(ctx, &pb.MediaStatusRequest{
+      Auth:    client.auth.ser(),
+      MediaId: id,
+    }, grpc.Header(&header))
+    if err != nil {
+      return nil, nil, err
+    }
+    if success, ok := resp.Response.(*pb.MediaResponse_Media); ok {
+      msg = success.Media
+      return header, nil, nil
+    } else if failure, ok := resp.Response.(*pb.MediaResponse_Error); ok {
+      return header, failure.Error, nil
+    } else {
+      return header, nil, errors.New("invalid response")
+    }
+  })
+  if err != nil {
+    return UploadedMedia{}, err
+  }
+  return desUploadedMedia(msg), nil
+}
@@ -120,3 +120,113 @@ type PollOption struct {
   Position uint
   Text     string
 }
+
+type RawResponse struct {
+  Status  uint
+  Headers map[string]string
+  Body    []byte
+}
+
+type UploadedMedia struct {
+  ID           uint64
+  Size         uint
+  ExpiresAfter time.Duration
+  Processing   *MediaProcessing
+}
+
+type MediaProcessingState uint8
+
+const (
+  MediaProcessingPending MediaProcessingState = iota
+  MediaProcessingInProgress
+  MediaProcessingSucceeded
+  MediaProcessingFailed
+)
+
+type MediaProcessing struct {
+  State      MediaProcessingState
+  CheckAfter time.Duration
+  Progress   uint
+  Error      string
+}
+
+// A page of user ids. NextCursor is zero if this is the last page.
+type UserIDPage struct {
+  IDs            []uint64
+  NextCursor     int64
+  PreviousCursor int64
+}
+
+// A page of users. NextCursor is zero if this is the last page.
+type UserPage struct {
+  Users          []User
+  NextCursor     int64
+  PreviousCursor int64
+}
+
+// The authenticated user's relationship with another user. User is set by every request except
+// GetRelationship, and FollowedBy and CanDM are only set by GetRelationship. Blocking and Muting are nil when
+// Twitter did not report them, which is the case for follow and unfollow, and for Muting also block and
+// unblock.
+type Relationship struct {
+  UserID               uint64
+  UserHandle           string
+  User                 *User
+  Following            bool
+  FollowingRequested   bool
+  Blocking             *bool
+  Muting               *bool
+  NotificationsEnabled bool
+  FollowedBy           bool
+  CanDM                bool
+}
+
+type DirectMessage struct {
+  ID          uint64
+  CreatedAt   time.Time
+  SenderID    uint64
+  RecipientID uint64
+  Text        string
+  Hashtags    []Symbol
+  URLs        []URL
+  Mentions    []Mention
+  Symbols     []Symbol
+  Media       []Media
+}
+
+// A page of direct messages. NextCursor is empty if this is the last page.
+type DirectMessagePage struct {
+  Messages   []DirectMessage
+  NextCursor string
+}
+
+type List struct {
+  ID              uint64
+  Name            string
+  Slug            string
+  FullName        string
+  Description     string
+  CreatedAt       time.Time
+  Private         bool
+  MemberCount     uint
+  SubscriberCount uint
+  Following       bool
+  Owner           User
+}
+
+// A page of lists. NextCursor is zero if this is the last page.
+type ListPage struct {
+  Lists          []List
+  NextCursor     int64
+  PreviousCursor int64
+}
+
+// One of Twitter's rate limits as tracked by the proxy. Key is either "group:" followed by the name of a group
+// of endpoints which share a limit, or "singleton:" followed by the path of an endpoint. Current and Next are
+// nil and Resets is the zero time if they are not known.
+type RateLimit struct {
+  Key     string
+  Current *uint
+  Next    *uint
+  Resets  time.Time
+}
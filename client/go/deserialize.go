@@ -66,6 +66,17 @@ func desTweet(msg *pb.Tweet) Tweet {
   return tweet
 }
 
+func desUsers(msg *pb.Users) []User {
+  if msg == nil {
+    return nil
+  }
+  users := make([]User, len(msg.Users))
+  for i, userMsg := range msg.Users {
+    users[i] = desUser(userMsg)
+  }
+  return users
+}
+
 func desUser(msg *pb.User) User {
   if msg == nil {
     return User{}
@@ -208,6 +219,50 @@ func desPolls(msgs []*pb.Poll) []Poll {
   return polls
 }
 
+func desRawResponse(msg *pb.RawAPIResult) RawResponse {
+  if msg == nil {
+    return RawResponse{}
+  }
+  return RawResponse{
+    Status:  uint(msg.Status),
+    Headers: msg.Headers,
+    Body:    msg.Body,
+  }
+}
+
+func desUploadedMedia(msg *pb.UploadedMedia) UploadedMedia {
+  if msg == nil {
+    return UploadedMedia{}
+  }
+  media := UploadedMedia{
+    ID:           msg.Id,
+    Size:         uint(msg.Size),
+    ExpiresAfter: time.Second * time.Duration(msg.ExpiresAfterSecs),
+  }
+  if msg.Processing != nil {
+    media.Processing = &MediaProcessing{
+      State:      desMediaProcessingState(msg.Processing.State),
+      CheckAfter: time.Second * time.Duration(msg.Processing.CheckAfterSecs),
+      Progress:   uint(msg.Processing.ProgressPercent),
+      Error:      msg.Processing.Error,
+    }
+  }
+  return media
+}
+
+func desMediaProcessingState(msg pb.UploadedMedia_Processing_State) MediaProcessingState {
+  switch msg {
+  case pb.UploadedMedia_Processing_IN_PROGRESS:
+    return MediaProcessingInProgress
+  case pb.UploadedMedia_Processing_SUCCEEDED:
+    return MediaProcessingSucceeded
+  case pb.UploadedMedia_Processing_FAILED:
+    return MediaProcessingFailed
+  default:
+    return MediaProcessingPending
+  }
+}
+
 func desOptFixed64(msg *pb.OptFixed64) *uint64 {
   if msg != nil {
     val := new(uint64)
@@ -217,6 +272,15 @@ func desOptFixed64(msg *pb.OptFixed64) *uint64 {
   return nil
 }
 
+func desOptBool(msg *pb.OptBool) *bool {
+  if msg != nil {
+    val := new(bool)
+    *val = msg.Val
+    return val
+  }
+  return nil
+}
+
 func desOptString(msg *pb.OptString) *string {
   if msg != nil {
     val := new(string)
@@ -225,3 +289,171 @@ func desOptString(msg *pb.OptString) *string {
   }
   return nil
 }
+
+func desUserIDPage(msg *pb.UserIdPage) UserIDPage {
+  if msg == nil {
+    return UserIDPage{}
+  }
+  return UserIDPage{
+    IDs:            msg.Ids,
+    NextCursor:     msg.NextCursor,
+    PreviousCursor: msg.PreviousCursor,
+  }
+}
+
+func desUserPage(msg *pb.UserPage) UserPage {
+  if msg == nil {
+    return UserPage{}
+  }
+  users := make([]User, len(msg.Users))
+  for i, userMsg := range msg.Users {
+    users[i] = desUser(userMsg)
+  }
+  return UserPage{
+    Users:          users,
+    NextCursor:     msg.NextCursor,
+    PreviousCursor: msg.PreviousCursor,
+  }
+}
+
+func desRelationship(msg *pb.Relationship) Relationship {
+  if msg == nil {
+    return Relationship{}
+  }
+  rel := Relationship{
+    UserID:               msg.UserId,
+    UserHandle:           msg.UserHandle,
+    Following:            msg.Following,
+    FollowingRequested:   msg.FollowingRequested,
+    Blocking:             desOptBool(msg.Blocking),
+    Muting:               desOptBool(msg.Muting),
+    NotificationsEnabled: msg.NotificationsEnabled,
+    FollowedBy:           msg.FollowedBy,
+    CanDM:                msg.CanDm,
+  }
+  if msg.User != nil {
+    rel.User = new(User)
+    *rel.User = desUser(msg.User)
+  }
+  return rel
+}
+
+func desDirectMessagePage(msg *pb.DirectMessagePage) DirectMessagePage {
+  if msg == nil {
+    return DirectMessagePage{}
+  }
+  messages := make([]DirectMessage, len(msg.Messages))
+  for i, dmMsg := range msg.Messages {
+    messages[i] = desDirectMessage(dmMsg)
+  }
+  return DirectMessagePage{
+    Messages:   messages,
+    NextCursor: msg.NextCursor,
+  }
+}
+
+func desDirectMessage(msg *pb.DirectMessage) DirectMessage {
+  if msg == nil {
+    return DirectMessage{}
+  }
+  return DirectMessage{
+    ID:          msg.Id,
+    CreatedAt:   time.Unix(msg.CreatedAt, 0),
+    SenderID:    msg.SenderId,
+    RecipientID: msg.RecipientId,
+    Text:        msg.Text,
+    Hashtags:    desSymbols(msg.Hashtags),
+    URLs:        desURLs(msg.Urls),
+    Mentions:    desMentions(msg.Mentions),
+    Symbols:     desSymbols(msg.Symbols),
+    Media:       desMediaItems(msg.Media),
+  }
+}
+
+func desListPage(msg *pb.ListPage) ListPage {
+  if msg == nil {
+    return ListPage{}
+  }
+  lists := make([]List, len(msg.Lists))
+  for i, listMsg := range msg.Lists {
+    lists[i] = desList(listMsg)
+  }
+  return ListPage{
+    Lists:          lists,
+    NextCursor:     msg.NextCursor,
+    PreviousCursor: msg.PreviousCursor,
+  }
+}
+
+func desList(msg *pb.List) List {
+  if msg == nil {
+    return List{}
+  }
+  return List{
+    ID:              msg.Id,
+    Name:            msg.Name,
+    Slug:            msg.Slug,
+    FullName:        msg.FullName,
+    Description:     msg.Description,
+    CreatedAt:       time.Unix(msg.CreatedAt, 0),
+    Private:         msg.Private,
+    MemberCount:     uint(msg.MemberCount),
+    SubscriberCount: uint(msg.SubscriberCount),
+    Following:       msg.Following,
+    Owner:           desUser(msg.Owner),
+  }
+}
+
+func desSearchResult(msg *pb.SearchResult) SearchResult {
+  if msg == nil {
+    return SearchResult{}
+  }
+  tweets := make([]Tweet, len(msg.Tweets))
+  for i, tweetMsg := range msg.Tweets {
+    tweets[i] = desTweet(tweetMsg)
+  }
+  return SearchResult{
+    Tweets:   tweets,
+    Metadata: desSearchMetadata(msg.Metadata),
+  }
+}
+
+func desSearchMetadata(msg *pb.SearchMetadata) SearchMetadata {
+  if msg == nil {
+    return SearchMetadata{}
+  }
+  return SearchMetadata{
+    NextResults: msg.NextResults,
+    RefreshURL:  msg.RefreshUrl,
+    MaxID:       msg.MaxId,
+    SinceID:     msg.SinceId,
+    Count:       uint(msg.Count),
+    CompletedIn: msg.CompletedIn,
+    Query:       msg.Query,
+    NextMaxID:   desOptFixed64(msg.NextMaxId),
+  }
+}
+
+func desRateLimits(msg *pb.RateLimits) []RateLimit {
+  limits := make([]RateLimit, len(msg.Limits))
+  for i, limitMsg := range msg.Limits {
+    limits[i] = desRateLimit(limitMsg)
+  }
+  return limits
+}
+
+func desRateLimit(msg *pb.RateLimit) RateLimit {
+  limit := RateLimit{Key: msg.Key}
+  if msg.Current != nil {
+    limit.Current = new(uint)
+    *limit.Current = uint(msg.Current.Val)
+  }
+  if msg.Next != nil {
+    limit.Next = new(uint)
+    *limit.Next = uint(msg.Next.Val)
+  }
+  if msg.Resets != 0 {
+    limit.Resets = time.Unix(msg.Resets, 0)
+  }
+  return limit
+}
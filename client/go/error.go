@@ -26,3 +26,79 @@ type AmbiguousRateLimitError struct{}
 func (err AmbiguousRateLimitError) Error() string {
   return "rate limit hit and reset time unknown"
 }
+
+// Returned when the client has used up the share of a rate limit which the proxy allows it.
+type QuotaExceededError struct {
+  message string
+  resets  time.Time
+}
+
+func (err QuotaExceededError) Error() string {
+  return err.message
+}
+
+func (err QuotaExceededError) ResetsTime() time.Time {
+  return err.resets
+}
+
+// Returned when Twitter responds to a request with an error. Errors which callers are likely to want to handle
+// differently have their own types (NotFoundError, UnauthorizedError, ForbiddenError and DuplicateError), which
+// unwrap to a TwitterError.
+type TwitterError struct {
+  message     string
+  twitterCode int
+  httpStatus  int
+}
+
+func (err TwitterError) Error() string {
+  return err.message
+}
+
+// Returns the error code from Twitter's response (e.g. 144 when a tweet does not exist), or 0 if it did not
+// include one.
+func (err TwitterError) TwitterCode() int {
+  return err.twitterCode
+}
+
+// Returns the HTTP status of Twitter's response, or 0 if it is unknown.
+func (err TwitterError) HTTPStatus() int {
+  return err.httpStatus
+}
+
+// Returned when the tweet, user or other resource requested does not exist.
+type NotFoundError struct {
+  TwitterError
+}
+
+func (err NotFoundError) Unwrap() error {
+  return err.TwitterError
+}
+
+// Returned when the access token is invalid or has been revoked.
+type UnauthorizedError struct {
+  TwitterError
+}
+
+func (err UnauthorizedError) Unwrap() error {
+  return err.TwitterError
+}
+
+// Returned when the account is not allowed to do what was requested, e.g. because it is suspended or the
+// tweet belongs to a protected user.
+type ForbiddenError struct {
+  TwitterError
+}
+
+func (err ForbiddenError) Unwrap() error {
+  return err.TwitterError
+}
+
+// Returned when the action has already been done, e.g. when publishing a duplicate status or liking a tweet
+// which is already liked.
+type DuplicateError struct {
+  TwitterError
+}
+
+func (err DuplicateError) Unwrap() error {
+  return err.TwitterError
+}
@@ -37,11 +37,11 @@ func main() {
   searchOpts := goldcrest.NewSearchOptions("aaaahhh (from:infinite_scream)")
   tlOpts := goldcrest.NewTimelineOptions(15)
 
-  tweets, err := client.SearchTweets(searchOpts, tlOpts)
+  result, err := client.SearchTweets(searchOpts, tlOpts)
 
   if err != nil {
     panic(err)
   }
 
-  spew.Dump(tweets)
+  spew.Dump(result.Tweets)
 }
\ No newline at end of file
@@ -96,8 +96,9 @@ func (opts TweetOptions) ser() *pb.TweetOptions {
 }
 
 type TimelineOptions struct {
-  count    uint
-  min, max *uint64
+  count                uint
+  min, max             *uint64
+  maxPages, totalCount uint
 }
 
 func NewTimelineOptions(count uint) TimelineOptions {
@@ -116,10 +117,26 @@ func (tlopts TimelineOptions) WithMax(max uint64) TimelineOptions {
   return tlopts
 }
 
+// Makes the proxy fetch up to the given number of pages of tweets, each older than the last, and return
+// them all at once.
+func (tlopts TimelineOptions) WithMaxPages(pages uint) TimelineOptions {
+  tlopts.maxPages = pages
+  return tlopts
+}
+
+// Makes the proxy keep fetching pages of tweets until it has the given number of tweets, the timeline runs
+// out or the rate limit is exhausted.
+func (tlopts TimelineOptions) WithTotalCount(total uint) TimelineOptions {
+  tlopts.totalCount = total
+  return tlopts
+}
+
 func (tlopts TimelineOptions) ser(twopts TweetOptions) *pb.TimelineOptions {
   msg := pb.TimelineOptions{
-    Count:  uint32(tlopts.count),
-    Twopts: twopts.ser(),
+    Count:      uint32(tlopts.count),
+    Twopts:     twopts.ser(),
+    MaxPages:   uint32(tlopts.maxPages),
+    TotalCount: uint32(tlopts.totalCount),
   }
   if tlopts.min != nil {
     msg.MinId = &pb.OptFixed64{Val: *tlopts.min}
@@ -130,6 +147,33 @@ func (tlopts TimelineOptions) ser(twopts TweetOptions) *pb.TimelineOptions {
   return &msg
 }
 
+// Options for endpoints which split their results into pages. A page's cursor is given by the NextCursor or
+// PreviousCursor of another page; if no cursor is given, the first page is returned.
+type CursorOptions struct {
+  count  uint
+  cursor *int64
+}
+
+func NewCursorOptions(count uint) CursorOptions {
+  return CursorOptions{count: count}
+}
+
+func (copts CursorOptions) WithCursor(cursor int64) CursorOptions {
+  copts.cursor = new(int64)
+  *copts.cursor = cursor
+  return copts
+}
+
+func (copts CursorOptions) ser() *pb.CursorOptions {
+  msg := pb.CursorOptions{
+    Count: uint32(copts.count),
+  }
+  if copts.cursor != nil {
+    msg.Cursor = &pb.OptInt64{Val: *copts.cursor}
+  }
+  return &msg
+}
+
 type SearchResultType uint8
 
 const (
@@ -216,6 +260,11 @@ func serSearchRequest(auth authentication, searchOpts SearchOptions, twOpts Twee
 
 type UserIdentifier interface {
   serIntoUserTimelineRequest(req *pb.UserTimelineRequest)
+  serIntoUserRequest(req *pb.UserRequest)
+  serIntoUsersRequest(req *pb.UsersRequest)
+  serIntoUserGraphRequest(req *pb.UserGraphRequest)
+  serIntoListMemberRequest(req *pb.ListMemberRequest)
+  serIntoOwnedListsRequest(req *pb.OwnedListsRequest)
 }
 
 type userIdentifierID uint64
@@ -228,6 +277,26 @@ func (uid userIdentifierID) serIntoUserTimelineRequest(req *pb.UserTimelineReque
   req.User = &pb.UserTimelineRequest_UserId{UserId: uint64(uid)}
 }
 
+func (uid userIdentifierID) serIntoUserRequest(req *pb.UserRequest) {
+  req.User = &pb.UserRequest_UserId{UserId: uint64(uid)}
+}
+
+func (uid userIdentifierID) serIntoUsersRequest(req *pb.UsersRequest) {
+  req.UserIds = append(req.UserIds, uint64(uid))
+}
+
+func (uid userIdentifierID) serIntoUserGraphRequest(req *pb.UserGraphRequest) {
+  req.User = &pb.UserGraphRequest_UserId{UserId: uint64(uid)}
+}
+
+func (uid userIdentifierID) serIntoListMemberRequest(req *pb.ListMemberRequest) {
+  req.User = &pb.ListMemberRequest_UserId{UserId: uint64(uid)}
+}
+
+func (uid userIdentifierID) serIntoOwnedListsRequest(req *pb.OwnedListsRequest) {
+  req.User = &pb.OwnedListsRequest_UserId{UserId: uint64(uid)}
+}
+
 type userIdentifierHandle string
 
 func UserHandle(handle string) UserIdentifier {
@@ -238,6 +307,26 @@ func (uid userIdentifierHandle) serIntoUserTimelineRequest(req *pb.UserTimelineR
   req.User = &pb.UserTimelineRequest_UserHandle{UserHandle: string(uid)}
 }
 
+func (uid userIdentifierHandle) serIntoUserRequest(req *pb.UserRequest) {
+  req.User = &pb.UserRequest_UserHandle{UserHandle: string(uid)}
+}
+
+func (uid userIdentifierHandle) serIntoUsersRequest(req *pb.UsersRequest) {
+  req.UserHandles = append(req.UserHandles, string(uid))
+}
+
+func (uid userIdentifierHandle) serIntoUserGraphRequest(req *pb.UserGraphRequest) {
+  req.User = &pb.UserGraphRequest_UserHandle{UserHandle: string(uid)}
+}
+
+func (uid userIdentifierHandle) serIntoListMemberRequest(req *pb.ListMemberRequest) {
+  req.User = &pb.ListMemberRequest_UserHandle{UserHandle: string(uid)}
+}
+
+func (uid userIdentifierHandle) serIntoOwnedListsRequest(req *pb.OwnedListsRequest) {
+  req.User = &pb.OwnedListsRequest_UserHandle{UserHandle: string(uid)}
+}
+
 type TweetComposer struct {
   text              string
   replyID           *uint64
@@ -313,6 +402,38 @@ func (com TweetComposer) ser(auth authentication, twopts TweetOptions) *pb.Publi
   return &req
 }
 
+type DirectMessageComposer struct {
+  recipientID uint64
+  text        string
+  mediaID     *uint64
+}
+
+func NewDirectMessageComposer(recipientID uint64, text string) DirectMessageComposer {
+  return DirectMessageComposer{
+    recipientID: recipientID,
+    text:        text,
+  }
+}
+
+// Attaches previously uploaded media to the message. Only one piece of media may be attached.
+func (com DirectMessageComposer) WithMedia(id uint64) DirectMessageComposer {
+  com.mediaID = new(uint64)
+  *com.mediaID = id
+  return com
+}
+
+func (com DirectMessageComposer) ser(auth authentication) *pb.SendDirectMessageRequest {
+  req := pb.SendDirectMessageRequest{
+    Auth:        auth.ser(),
+    RecipientId: com.recipientID,
+    Text:        com.text,
+  }
+  if com.mediaID != nil {
+    req.MediaId = &pb.OptFixed64{Val: *com.mediaID}
+  }
+  return &req
+}
+
 type ProfileUpdater struct {
   name             *string
   url              *string
@@ -0,0 +1,52 @@
+package goldcrest
+
+import (
+  "errors"
+)
+
+// Returned by SearchResult.Next when there are no older results.
+var ErrNoMoreResults = errors.New("no more search results")
+
+type SearchMetadata struct {
+  NextResults string
+  RefreshURL  string
+  MaxID       uint64
+  SinceID     uint64
+  Count       uint
+  CompletedIn float64
+  Query       string
+  // The max id of the next page of older results, or nil if there are no more results
+  NextMaxID *uint64
+}
+
+// The result of a search, which remembers the search that produced it so that the search can be
+// continued.
+type SearchResult struct {
+  Tweets   []Tweet
+  Metadata SearchMetadata
+
+  client     Client
+  searchOpts SearchOptions
+  tlOpts     TimelineOptions
+}
+
+// Reports whether there are older results which can be fetched with Next.
+func (res SearchResult) HasNext() bool {
+  return res.Metadata.NextMaxID != nil
+}
+
+// Fetches the next page of older results, using the same search options as this result. Returns
+// ErrNoMoreResults if there are none.
+func (res SearchResult) Next() (SearchResult, error) {
+  if res.Metadata.NextMaxID == nil {
+    return SearchResult{}, ErrNoMoreResults
+  }
+  return res.client.SearchTweets(res.searchOpts, res.tlOpts.WithMax(*res.Metadata.NextMaxID))
+}
+
+// Searches for results newer than the newest result of this search.
+func (res SearchResult) Refresh() (SearchResult, error) {
+  tlOpts := res.tlOpts
+  tlOpts.max = nil
+  return res.client.SearchTweets(res.searchOpts, tlOpts.WithMin(res.Metadata.MaxID+1))
+}
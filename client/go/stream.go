@@ -0,0 +1,92 @@
+package goldcrest
+
+import (
+  "context"
+  "errors"
+  pb "github.com/pantonshire/goldcrest/protocol"
+)
+
+// A stream of tweets which are pushed by the proxy as it finds them. Next returns io.EOF once the stream
+// has been closed by the proxy.
+type TweetStream struct {
+  stream pb.Twitter_StreamTweetsClient
+  cancel context.CancelFunc
+}
+
+// Blocks until the next tweet is received from the stream.
+func (ts TweetStream) Next() (Tweet, error) {
+  resp, err := ts.stream.Recv()
+  if err != nil {
+    return Tweet{}, desStreamError(nil, err)
+  }
+  if success, ok := resp.Response.(*pb.TweetResponse_Tweet); ok {
+    return desTweet(success.Tweet), nil
+  } else if failure, ok := resp.Response.(*pb.TweetResponse_Error); ok {
+    return Tweet{}, desStreamError(failure.Error, nil)
+  } else {
+    return Tweet{}, errors.New("invalid response")
+  }
+}
+
+// Stops receiving tweets from the stream.
+func (ts TweetStream) Close() {
+  ts.cancel()
+}
+
+// Iterates over pages of user ids, fetching each page from the proxy as it is needed. Next returns io.EOF
+// once the last page has been received.
+type UserIDIterator struct {
+  stream interface {
+    Recv() (*pb.UserIdsResponse, error)
+  }
+  cancel context.CancelFunc
+}
+
+// Blocks until the next page of user ids is received.
+func (it UserIDIterator) Next() (UserIDPage, error) {
+  resp, err := it.stream.Recv()
+  if err != nil {
+    return UserIDPage{}, desStreamError(nil, err)
+  }
+  if success, ok := resp.Response.(*pb.UserIdsResponse_Page); ok {
+    return desUserIDPage(success.Page), nil
+  } else if failure, ok := resp.Response.(*pb.UserIdsResponse_Error); ok {
+    return UserIDPage{}, desStreamError(failure.Error, nil)
+  } else {
+    return UserIDPage{}, errors.New("invalid response")
+  }
+}
+
+// Stops fetching pages.
+func (it UserIDIterator) Close() {
+  it.cancel()
+}
+
+// Iterates over pages of users, fetching each page from the proxy as it is needed. Next returns io.EOF once
+// the last page has been received.
+type UserIterator struct {
+  stream interface {
+    Recv() (*pb.UserPageResponse, error)
+  }
+  cancel context.CancelFunc
+}
+
+// Blocks until the next page of users is received.
+func (it UserIterator) Next() (UserPage, error) {
+  resp, err := it.stream.Recv()
+  if err != nil {
+    return UserPage{}, desStreamError(nil, err)
+  }
+  if success, ok := resp.Response.(*pb.UserPageResponse_Page); ok {
+    return desUserPage(success.Page), nil
+  } else if failure, ok := resp.Response.(*pb.UserPageResponse_Error); ok {
+    return UserPage{}, desStreamError(failure.Error, nil)
+  } else {
+    return UserPage{}, errors.New("invalid response")
+  }
+}
+
+// Stops fetching pages.
+func (it UserIterator) Close() {
+  it.cancel()
+}
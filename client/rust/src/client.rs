@@ -144,7 +144,7 @@ impl Client {
         request!(self, req, get_tweets)
     }
 
-    pub async fn search_tweets(&self, search_opts: request::SearchOptions, tweet_opts: request::TweetOptions, timeline_opts: request::TimelineOptions) -> RequestResult<Vec<data::Tweet>> {
+    pub async fn search_tweets(&self, search_opts: request::SearchOptions, tweet_opts: request::TweetOptions, timeline_opts: request::TimelineOptions) -> RequestResult<data::SearchResult> {
         let req = serialize::ser_search_request(self.authentication.clone(), search_opts, tweet_opts, timeline_opts);
         request!(self, req, search_tweets)
     }
@@ -200,6 +200,30 @@ pub mod tweet {
     }
 }
 
+/// A page of search results.
+#[derive(Clone, Debug)]
+pub struct SearchResult {
+    pub tweets: Vec<Tweet>,
+    pub metadata: SearchMetadata,
+}
+
+/// The search metadata reported by Twitter.
+#[derive(Clone, Debug)]
+pub struct SearchMetadata {
+    /// The query string to get the next page of older results, or empty if there are no more results.
+    pub next_results: String,
+    /// The query string to get results newer than these.
+    pub refresh_url: String,
+    pub max_id: u64,
+    pub since_id: u64,
+    pub count: u32,
+    pub completed_in: f64,
+    pub query: String,
+    /// The max ID to use in the timeline options of the next search to get the next page of older
+    /// results, or None if there are no more results.
+    pub next_max_id: Option<u64>,
+}
+
 /// Represents a handle that uniquely identifies a user.
 /// Referred to as "screen name" in the Twitter API.
 /// Note that a user may change their handle, so user IDs should be preferred as a way of
@@ -103,6 +103,30 @@ impl Deserialize<Vec<Tweet>> for twitter1::Tweets {
     }
 }
 
+impl Deserialize<SearchResult> for twitter1::SearchResult {
+    fn des(self) -> DeserializationResult<SearchResult> {
+        Ok(SearchResult{
+            tweets: self.tweets.des()?,
+            metadata: self.metadata.exists()?.des()?,
+        })
+    }
+}
+
+impl Deserialize<SearchMetadata> for twitter1::SearchMetadata {
+    fn des(self) -> DeserializationResult<SearchMetadata> {
+        Ok(SearchMetadata{
+            next_results: self.next_results,
+            refresh_url: self.refresh_url,
+            max_id: self.max_id,
+            since_id: self.since_id,
+            count: self.count,
+            completed_in: self.completed_in,
+            query: self.query,
+            next_max_id: self.next_max_id.map(u64::from),
+        })
+    }
+}
+
 impl Deserialize<ReplyData> for twitter1::tweet::ReplyData {
     fn des(self) -> DeserializationResult<ReplyData> {
         Ok(ReplyData{
@@ -30,3 +30,12 @@ impl Response<twitter1::User> for twitter1::UserResponse {
         })
     }
 }
+
+impl Response<twitter1::SearchResult> for twitter1::SearchResponse {
+    fn response_result(self) -> Option<Result<twitter1::SearchResult, twitter1::Error>> {
+        Some(match self.response? {
+            twitter1::search_response::Response::Result(msg) => Ok(msg),
+            twitter1::search_response::Response::Error(err) => Err(err),
+        })
+    }
+}
@@ -1,6 +1,10 @@
 package main
 
 import (
+  "context"
+  "crypto/tls"
+  "crypto/x509"
+  "errors"
   "fmt"
   "github.com/jessevdk/go-flags"
   pb "github.com/pantonshire/goldcrest/protocol"
@@ -11,31 +15,81 @@ import (
   "gopkg.in/yaml.v2"
   "io/ioutil"
   "net"
+  "net/http"
   "os"
   "os/signal"
   "syscall"
   "time"
 )
 
-const defaultConfigPath = "goldcrest.yaml"
+const (
+  defaultConfigPath = "goldcrest.yaml"
+  // How long to wait for requests to finish when shutting down before cancelling them
+  shutdownTimeout = time.Second * 30
+)
 
 type config struct {
   Server struct {
     Port           uint          `yaml:"port"`
     ConnectTimeout time.Duration `yaml:"connect_timeout"`
+    MaxReceiveSize int           `yaml:"max_receive_size"`
+    StatusErrors   bool          `yaml:"status_errors"`
     TLS            struct {
-      Enabled bool   `yaml:"enabled"`
-      Crt     string `yaml:"crt"`
-      Key     string `yaml:"key"`
+      Enabled  bool   `yaml:"enabled"`
+      Crt      string `yaml:"crt"`
+      Key      string `yaml:"key"`
+      ClientCA string `yaml:"client_ca"`
     } `yaml:"tls"`
+    Metrics struct {
+      Address string `yaml:"address"`
+    } `yaml:"metrics"`
+    Tracing tracingConfig `yaml:"tracing"`
+    Log     struct {
+      Level  string `yaml:"level"`
+      Format string `yaml:"format"`
+    } `yaml:"log"`
   } `yaml:"server"`
+  Clients []struct {
+    Name        string   `yaml:"name"`
+    APIKeys     []string `yaml:"api_keys"`
+    CommonNames []string `yaml:"common_names"`
+    Quotas      map[string]struct {
+      Requests uint          `yaml:"requests"`
+      Window   time.Duration `yaml:"window"`
+    } `yaml:"quotas"`
+  } `yaml:"clients"`
   Client struct {
     Timeout   time.Duration `yaml:"timeout"`
     Protocol  string        `yaml:"protocol"`
     BaseURL   string        `yaml:"base_url"`
+    UploadURL string        `yaml:"upload_url"`
     RateLimit struct {
-      AssumeNext bool `yaml:"assume_next"`
+      AssumeNext bool            `yaml:"assume_next"`
+      Reserve    map[string]uint `yaml:"reserve"`
+      WarmUp     bool            `yaml:"warm_up"`
+      Persist    struct {
+        Path     string        `yaml:"path"`
+        Interval time.Duration `yaml:"interval"`
+      } `yaml:"persist"`
+      Shared struct {
+        RedisAddress  string `yaml:"redis_address"`
+        RedisPassword string `yaml:"redis_password"`
+        RedisDB       int    `yaml:"redis_db"`
+        KeyPrefix     string `yaml:"key_prefix"`
+      } `yaml:"shared"`
     } `yaml:"rate_limit"`
+    Cache struct {
+      TTL        time.Duration `yaml:"ttl"`
+      MaxEntries int           `yaml:"max_entries"`
+    } `yaml:"cache"`
+    Batch struct {
+      Window time.Duration `yaml:"window"`
+    } `yaml:"batch"`
+    Retry struct {
+      Retries    uint          `yaml:"retries"`
+      Backoff    time.Duration `yaml:"backoff"`
+      MaxBackoff time.Duration `yaml:"max_backoff"`
+    } `yaml:"retry"`
   } `yaml:"client"`
 }
 
@@ -72,7 +126,20 @@ func main() {
   }
 
   log := logrus.New()
-  log.SetLevel(logrus.InfoLevel)
+  if conf.Server.Log.Level != "" {
+    level, err := logrus.ParseLevel(conf.Server.Log.Level)
+    if err != nil {
+      panic(err)
+    }
+    log.SetLevel(level)
+  }
+  switch conf.Server.Log.Format {
+  case "", "text":
+  case "json":
+    log.SetFormatter(&logrus.JSONFormatter{})
+  default:
+    panic(errors.New("unknown log format " + conf.Server.Log.Format))
+  }
 
   address := fmt.Sprintf(":%d", conf.Server.Port)
   listener, err := net.Listen("tcp", address)
@@ -88,26 +155,91 @@ func main() {
     opts = append(opts, grpc.ConnectionTimeout(conf.Server.ConnectTimeout))
   }
 
+  if conf.Server.MaxReceiveSize > 0 {
+    opts = append(opts, grpc.MaxRecvMsgSize(conf.Server.MaxReceiveSize))
+  }
+
   if conf.Server.TLS.Enabled {
-    creds, err := credentials.NewServerTLSFromFile(conf.Server.TLS.Crt, conf.Server.TLS.Key)
+    cert, err := tls.LoadX509KeyPair(conf.Server.TLS.Crt, conf.Server.TLS.Key)
     if err != nil {
       panic(err)
     }
-    opts = append(opts, grpc.Creds(creds))
+    tlsConf := &tls.Config{Certificates: []tls.Certificate{cert}}
+    // Clients may present a certificate signed by the CA to identify themselves
+    if conf.Server.TLS.ClientCA != "" {
+      caData, err := ioutil.ReadFile(conf.Server.TLS.ClientCA)
+      if err != nil {
+        panic(err)
+      }
+      pool := x509.NewCertPool()
+      if !pool.AppendCertsFromPEM(caData) {
+        panic(errors.New("no certificates found in " + conf.Server.TLS.ClientCA))
+      }
+      tlsConf.ClientCAs = pool
+      tlsConf.ClientAuth = tls.VerifyClientCertIfGiven
+    }
+    opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConf)))
+  }
+
+  proxyConf := proxy.Config{
+    TwitterTimeout:     conf.Client.Timeout,
+    TwitterProtocol:    conf.Client.Protocol,
+    TwitterURL:         conf.Client.BaseURL,
+    TwitterUploadURL:   conf.Client.UploadURL,
+    AssumeNextLimit:    conf.Client.RateLimit.AssumeNext,
+    LimitSaveInterval:  conf.Client.RateLimit.Persist.Interval,
+    CacheTTL:           conf.Client.Cache.TTL,
+    CacheSize:          conf.Client.Cache.MaxEntries,
+    BatchWindow:        conf.Client.Batch.Window,
+    LimitReserves:      conf.Client.RateLimit.Reserve,
+    WarmUpLimits:       conf.Client.RateLimit.WarmUp,
+    StatusErrors:       conf.Server.StatusErrors,
+    UpstreamRetries:    conf.Client.Retry.Retries,
+    UpstreamBackoff:    conf.Client.Retry.Backoff,
+    UpstreamMaxBackoff: conf.Client.Retry.MaxBackoff,
+  }
+  for _, client := range conf.Clients {
+    identity := proxy.ClientIdentity{
+      Name:        client.Name,
+      APIKeys:     client.APIKeys,
+      CommonNames: client.CommonNames,
+      Quotas:      make(map[string]proxy.Quota, len(client.Quotas)),
+    }
+    for limit, quota := range client.Quotas {
+      identity.Quotas[limit] = proxy.Quota{Requests: quota.Requests, Window: quota.Window}
+    }
+    proxyConf.Clients = append(proxyConf.Clients, identity)
   }
 
-  server := grpc.NewServer(opts...)
+  if conf.Client.RateLimit.Persist.Path != "" {
+    proxyConf.LimitStore = proxy.NewFileLimitStore(conf.Client.RateLimit.Persist.Path)
+  }
+
+  if shared := conf.Client.RateLimit.Shared; shared.RedisAddress != "" {
+    store := proxy.NewRedisLimitStore(shared.RedisAddress, shared.RedisPassword, shared.RedisDB, shared.KeyPrefix)
+    defer store.Close()
+    proxyConf.SharedLimitStore = store
+  }
+
+  tracerProvider, err := newTracerProvider(conf.Server.Tracing)
+  if err != nil {
+    panic(err)
+  }
+  if tracerProvider != nil {
+    defer tracerProvider.Shutdown(context.Background())
+    proxyConf.TracerProvider = tracerProvider
+  }
 
-  prox := proxy.NewProxy(
-    log,
-    conf.Client.Timeout,
-    conf.Client.Protocol,
-    conf.Client.BaseURL,
-    conf.Client.RateLimit.AssumeNext,
-  )
+  prox, err := proxy.NewProxy(log, proxyConf)
+  if err != nil {
+    panic(err)
+  }
+  defer prox.Close()
+
+  server := grpc.NewServer(append(opts, prox.ServerOptions()...)...)
   pb.RegisterTwitterServer(server, prox)
 
-  fatal := make(chan error, 1)
+  fatal := make(chan error, 2)
 
   go func() {
     if err := server.Serve(listener); err != nil {
@@ -115,6 +247,19 @@ func main() {
     }
   }()
 
+  if conf.Server.Metrics.Address != "" {
+    mux := http.NewServeMux()
+    mux.Handle("/metrics", prox.MetricsHandler())
+    metricsServer := &http.Server{Addr: conf.Server.Metrics.Address, Handler: mux}
+    defer metricsServer.Close()
+    go func() {
+      if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+        fatal <- err
+      }
+    }()
+    log.Info("Serving metrics at " + conf.Server.Metrics.Address)
+  }
+
   interrupt := make(chan os.Signal, 1)
   signal.Notify(interrupt, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
   defer signal.Stop(interrupt)
@@ -122,7 +267,19 @@ func main() {
   select {
   case <-interrupt:
     log.Info("Shutting down")
-    server.GracefulStop()
+    // Streams only end when their clients go away, so end them rather than waiting for them
+    prox.EndStreams()
+    stopped := make(chan struct{})
+    go func() {
+      server.GracefulStop()
+      close(stopped)
+    }()
+    select {
+    case <-stopped:
+    case <-time.After(shutdownTimeout):
+      log.Warn("Timed out waiting for requests to finish")
+      server.Stop()
+    }
     log.Info("Goodbye!")
   case err := <-fatal:
     panic(err)
@@ -0,0 +1,54 @@
+package main
+
+import (
+  "context"
+  "fmt"
+  "go.opentelemetry.io/otel/attribute"
+  "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+  "go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+  "go.opentelemetry.io/otel/sdk/resource"
+  sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const defaultServiceName = "goldcrest"
+
+type tracingConfig struct {
+  Exporter     string `yaml:"exporter"`
+  OTLPEndpoint string `yaml:"otlp_endpoint"`
+  OTLPInsecure bool   `yaml:"otlp_insecure"`
+  ServiceName  string `yaml:"service_name"`
+}
+
+// Returns nil if tracing is disabled.
+func newTracerProvider(conf tracingConfig) (*sdktrace.TracerProvider, error) {
+  var (
+    exporter sdktrace.SpanExporter
+    err      error
+  )
+  switch conf.Exporter {
+  case "":
+    return nil, nil
+  case "otlp":
+    opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(conf.OTLPEndpoint)}
+    if conf.OTLPInsecure {
+      opts = append(opts, otlptracegrpc.WithInsecure())
+    }
+    exporter, err = otlptracegrpc.New(context.Background(), opts...)
+  case "stdout":
+    exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+  default:
+    return nil, fmt.Errorf("unknown trace exporter %q", conf.Exporter)
+  }
+  if err != nil {
+    return nil, err
+  }
+
+  serviceName := conf.ServiceName
+  if serviceName == "" {
+    serviceName = defaultServiceName
+  }
+  return sdktrace.NewTracerProvider(
+    sdktrace.WithBatcher(exporter),
+    sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+  ), nil
+}
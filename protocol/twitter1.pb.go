@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.23.0
+// 	protoc-gen-go v1.28.0
 // 	protoc        v3.14.0
 // source: twitter1.proto
 
@@ -8,7 +8,6 @@ package protocol
 
 import (
 	context "context"
-	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -25,10 +24,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type Error_Code int32
 
 const (
@@ -36,6 +31,16 @@ const (
 	Error_TWITTER_ERROR Error_Code = 1
 	Error_BAD_REQUEST   Error_Code = 2
 	Error_BAD_RESPONSE  Error_Code = 3
+	// The client has used up the share of the rate limit which the proxy allows it
+	Error_QUOTA_EXCEEDED Error_Code = 4
+	// The tweet, user or other resource does not exist
+	Error_NOT_FOUND Error_Code = 5
+	// The access token is invalid or has been revoked
+	Error_UNAUTHORIZED Error_Code = 6
+	// The account is not allowed to do this, e.g. because it is suspended or the tweet is protected
+	Error_FORBIDDEN Error_Code = 7
+	// The action has already been done, e.g. the status is a duplicate or the tweet is already liked
+	Error_DUPLICATE Error_Code = 8
 )
 
 // Enum value maps for Error_Code.
@@ -45,12 +50,22 @@ var (
 		1: "TWITTER_ERROR",
 		2: "BAD_REQUEST",
 		3: "BAD_RESPONSE",
+		4: "QUOTA_EXCEEDED",
+		5: "NOT_FOUND",
+		6: "UNAUTHORIZED",
+		7: "FORBIDDEN",
+		8: "DUPLICATE",
 	}
 	Error_Code_value = map[string]int32{
-		"RATE_LIMIT":    0,
-		"TWITTER_ERROR": 1,
-		"BAD_REQUEST":   2,
-		"BAD_RESPONSE":  3,
+		"RATE_LIMIT":     0,
+		"TWITTER_ERROR":  1,
+		"BAD_REQUEST":    2,
+		"BAD_RESPONSE":   3,
+		"QUOTA_EXCEEDED": 4,
+		"NOT_FOUND":      5,
+		"UNAUTHORIZED":   6,
+		"FORBIDDEN":      7,
+		"DUPLICATE":      8,
 	}
 )
 
@@ -78,7 +93,7 @@ func (x Error_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Error_Code.Descriptor instead.
 func (Error_Code) EnumDescriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{4, 0}
+	return file_twitter1_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type TweetOptions_Mode int32
@@ -124,7 +139,7 @@ func (x TweetOptions_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TweetOptions_Mode.Descriptor instead.
 func (TweetOptions_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{7, 0}
+	return file_twitter1_proto_rawDescGZIP(), []int{8, 0}
 }
 
 type SearchRequest_ResultType int32
@@ -173,7 +188,59 @@ func (x SearchRequest_ResultType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SearchRequest_ResultType.Descriptor instead.
 func (SearchRequest_ResultType) EnumDescriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{11, 0}
+	return file_twitter1_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type UploadedMedia_Processing_State int32
+
+const (
+	UploadedMedia_Processing_PENDING     UploadedMedia_Processing_State = 0
+	UploadedMedia_Processing_IN_PROGRESS UploadedMedia_Processing_State = 1
+	UploadedMedia_Processing_SUCCEEDED   UploadedMedia_Processing_State = 2
+	UploadedMedia_Processing_FAILED      UploadedMedia_Processing_State = 3
+)
+
+// Enum value maps for UploadedMedia_Processing_State.
+var (
+	UploadedMedia_Processing_State_name = map[int32]string{
+		0: "PENDING",
+		1: "IN_PROGRESS",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	UploadedMedia_Processing_State_value = map[string]int32{
+		"PENDING":     0,
+		"IN_PROGRESS": 1,
+		"SUCCEEDED":   2,
+		"FAILED":      3,
+	}
+)
+
+func (x UploadedMedia_Processing_State) Enum() *UploadedMedia_Processing_State {
+	p := new(UploadedMedia_Processing_State)
+	*p = x
+	return p
+}
+
+func (x UploadedMedia_Processing_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UploadedMedia_Processing_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_twitter1_proto_enumTypes[3].Descriptor()
+}
+
+func (UploadedMedia_Processing_State) Type() protoreflect.EnumType {
+	return &file_twitter1_proto_enumTypes[3]
+}
+
+func (x UploadedMedia_Processing_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UploadedMedia_Processing_State.Descriptor instead.
+func (UploadedMedia_Processing_State) EnumDescriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{64, 0, 0}
 }
 
 type OptInt64 struct {
@@ -364,6 +431,53 @@ func (x *OptString) GetVal() string {
 	return ""
 }
 
+type OptBool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Val bool `protobuf:"varint,1,opt,name=val,proto3" json:"val,omitempty"`
+}
+
+func (x *OptBool) Reset() {
+	*x = OptBool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptBool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptBool) ProtoMessage() {}
+
+func (x *OptBool) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptBool.ProtoReflect.Descriptor instead.
+func (*OptBool) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OptBool) GetVal() bool {
+	if x != nil {
+		return x.Val
+	}
+	return false
+}
+
 type Error struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -371,12 +485,16 @@ type Error struct {
 
 	Code    Error_Code `protobuf:"varint,1,opt,name=code,proto3,enum=twitter1.Error_Code" json:"code,omitempty"`
 	Message string     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The error code from Twitter's response body, or 0 if it did not include one
+	TwitterCode uint32 `protobuf:"varint,3,opt,name=twitter_code,json=twitterCode,proto3" json:"twitter_code,omitempty"`
+	// The HTTP status of Twitter's response, or 0 if the error did not come from Twitter
+	HttpStatus uint32 `protobuf:"varint,4,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
 }
 
 func (x *Error) Reset() {
 	*x = Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[4]
+		mi := &file_twitter1_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +507,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[4]
+	mi := &file_twitter1_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +520,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{4}
+	return file_twitter1_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Error) GetCode() Error_Code {
@@ -419,6 +537,20 @@ func (x *Error) GetMessage() string {
 	return ""
 }
 
+func (x *Error) GetTwitterCode() uint32 {
+	if x != nil {
+		return x.TwitterCode
+	}
+	return 0
+}
+
+func (x *Error) GetHttpStatus() uint32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
 type Authentication struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -433,7 +565,7 @@ type Authentication struct {
 func (x *Authentication) Reset() {
 	*x = Authentication{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[5]
+		mi := &file_twitter1_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -446,7 +578,7 @@ func (x *Authentication) String() string {
 func (*Authentication) ProtoMessage() {}
 
 func (x *Authentication) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[5]
+	mi := &file_twitter1_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +591,7 @@ func (x *Authentication) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Authentication.ProtoReflect.Descriptor instead.
 func (*Authentication) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{5}
+	return file_twitter1_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Authentication) GetConsumerKey() string {
@@ -502,7 +634,7 @@ type Indices struct {
 func (x *Indices) Reset() {
 	*x = Indices{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[6]
+		mi := &file_twitter1_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -515,7 +647,7 @@ func (x *Indices) String() string {
 func (*Indices) ProtoMessage() {}
 
 func (x *Indices) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[6]
+	mi := &file_twitter1_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +660,7 @@ func (x *Indices) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Indices.ProtoReflect.Descriptor instead.
 func (*Indices) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{6}
+	return file_twitter1_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Indices) GetStart() uint32 {
@@ -561,7 +693,7 @@ type TweetOptions struct {
 func (x *TweetOptions) Reset() {
 	*x = TweetOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[7]
+		mi := &file_twitter1_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -574,7 +706,7 @@ func (x *TweetOptions) String() string {
 func (*TweetOptions) ProtoMessage() {}
 
 func (x *TweetOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[7]
+	mi := &file_twitter1_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +719,7 @@ func (x *TweetOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TweetOptions.ProtoReflect.Descriptor instead.
 func (*TweetOptions) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{7}
+	return file_twitter1_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TweetOptions) GetTrimUser() bool {
@@ -643,12 +775,17 @@ type TimelineOptions struct {
 	/// The largest id of Tweets that can be included in the timeline, inclusive
 	MaxId  *OptFixed64   `protobuf:"bytes,3,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
 	Twopts *TweetOptions `protobuf:"bytes,4,opt,name=twopts,proto3" json:"twopts,omitempty"`
+	/// If greater than one, the proxy fetches up to this many pages of count tweets, each older than the last
+	MaxPages uint32 `protobuf:"varint,5,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	/// If set, the proxy fetches pages until it has this many tweets. Paging stops early if the timeline is
+	/// exhausted or the rate limit runs out
+	TotalCount uint32 `protobuf:"varint,6,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
 func (x *TimelineOptions) Reset() {
 	*x = TimelineOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[8]
+		mi := &file_twitter1_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -661,7 +798,7 @@ func (x *TimelineOptions) String() string {
 func (*TimelineOptions) ProtoMessage() {}
 
 func (x *TimelineOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[8]
+	mi := &file_twitter1_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +811,7 @@ func (x *TimelineOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimelineOptions.ProtoReflect.Descriptor instead.
 func (*TimelineOptions) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{8}
+	return file_twitter1_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TimelineOptions) GetCount() uint32 {
@@ -705,6 +842,77 @@ func (x *TimelineOptions) GetTwopts() *TweetOptions {
 	return nil
 }
 
+func (x *TimelineOptions) GetMaxPages() uint32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+func (x *TimelineOptions) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CursorOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	/// The cursor of the page to get, as given by next_cursor or previous_cursor of another page. The first
+	/// page is returned if this is not set
+	Cursor *OptInt64 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *CursorOptions) Reset() {
+	*x = CursorOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CursorOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CursorOptions) ProtoMessage() {}
+
+func (x *CursorOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CursorOptions.ProtoReflect.Descriptor instead.
+func (*CursorOptions) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CursorOptions) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CursorOptions) GetCursor() *OptInt64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
 type TweetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -718,7 +926,7 @@ type TweetRequest struct {
 func (x *TweetRequest) Reset() {
 	*x = TweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[9]
+		mi := &file_twitter1_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -731,7 +939,7 @@ func (x *TweetRequest) String() string {
 func (*TweetRequest) ProtoMessage() {}
 
 func (x *TweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[9]
+	mi := &file_twitter1_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +952,7 @@ func (x *TweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TweetRequest.ProtoReflect.Descriptor instead.
 func (*TweetRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{9}
+	return file_twitter1_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TweetRequest) GetAuth() *Authentication {
@@ -781,7 +989,7 @@ type TweetsRequest struct {
 func (x *TweetsRequest) Reset() {
 	*x = TweetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[10]
+		mi := &file_twitter1_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -794,7 +1002,7 @@ func (x *TweetsRequest) String() string {
 func (*TweetsRequest) ProtoMessage() {}
 
 func (x *TweetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[10]
+	mi := &file_twitter1_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,7 +1015,7 @@ func (x *TweetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TweetsRequest.ProtoReflect.Descriptor instead.
 func (*TweetsRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{10}
+	return file_twitter1_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TweetsRequest) GetAuth() *Authentication {
@@ -849,7 +1057,7 @@ type SearchRequest struct {
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[11]
+		mi := &file_twitter1_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -862,7 +1070,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[11]
+	mi := &file_twitter1_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1083,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{11}
+	return file_twitter1_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SearchRequest) GetAuth() *Authentication {
@@ -947,7 +1155,7 @@ type HomeTimelineRequest struct {
 func (x *HomeTimelineRequest) Reset() {
 	*x = HomeTimelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[12]
+		mi := &file_twitter1_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -960,7 +1168,7 @@ func (x *HomeTimelineRequest) String() string {
 func (*HomeTimelineRequest) ProtoMessage() {}
 
 func (x *HomeTimelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[12]
+	mi := &file_twitter1_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1181,7 @@ func (x *HomeTimelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HomeTimelineRequest.ProtoReflect.Descriptor instead.
 func (*HomeTimelineRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{12}
+	return file_twitter1_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *HomeTimelineRequest) GetAuth() *Authentication {
@@ -1009,7 +1217,7 @@ type MentionTimelineRequest struct {
 func (x *MentionTimelineRequest) Reset() {
 	*x = MentionTimelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[13]
+		mi := &file_twitter1_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1022,7 +1230,7 @@ func (x *MentionTimelineRequest) String() string {
 func (*MentionTimelineRequest) ProtoMessage() {}
 
 func (x *MentionTimelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[13]
+	mi := &file_twitter1_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,7 +1243,7 @@ func (x *MentionTimelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MentionTimelineRequest.ProtoReflect.Descriptor instead.
 func (*MentionTimelineRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{13}
+	return file_twitter1_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MentionTimelineRequest) GetAuth() *Authentication {
@@ -1070,7 +1278,7 @@ type UserTimelineRequest struct {
 func (x *UserTimelineRequest) Reset() {
 	*x = UserTimelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[14]
+		mi := &file_twitter1_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1083,7 +1291,7 @@ func (x *UserTimelineRequest) String() string {
 func (*UserTimelineRequest) ProtoMessage() {}
 
 func (x *UserTimelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[14]
+	mi := &file_twitter1_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +1304,7 @@ func (x *UserTimelineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserTimelineRequest.ProtoReflect.Descriptor instead.
 func (*UserTimelineRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{14}
+	return file_twitter1_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UserTimelineRequest) GetAuth() *Authentication {
@@ -1185,7 +1393,7 @@ type PublishTweetRequest struct {
 func (x *PublishTweetRequest) Reset() {
 	*x = PublishTweetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[15]
+		mi := &file_twitter1_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1198,7 +1406,7 @@ func (x *PublishTweetRequest) String() string {
 func (*PublishTweetRequest) ProtoMessage() {}
 
 func (x *PublishTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[15]
+	mi := &file_twitter1_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,7 +1419,7 @@ func (x *PublishTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishTweetRequest.ProtoReflect.Descriptor instead.
 func (*PublishTweetRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{15}
+	return file_twitter1_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PublishTweetRequest) GetAuth() *Authentication {
@@ -1291,38 +1499,38 @@ func (x *PublishTweetRequest) GetTwopts() *TweetOptions {
 	return nil
 }
 
-type UpdateProfileRequest struct {
+type StreamTweetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth            *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
-	Name            *OptString      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Url             *OptString      `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Location        *OptString      `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	Bio             *OptString      `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
-	LinkColor       *OptString      `protobuf:"bytes,6,opt,name=link_color,json=linkColor,proto3" json:"link_color,omitempty"`
-	IncludeEntities bool            `protobuf:"varint,7,opt,name=include_entities,json=includeEntities,proto3" json:"include_entities,omitempty"`
-	IncludeStatuses bool            `protobuf:"varint,8,opt,name=include_statuses,json=includeStatuses,proto3" json:"include_statuses,omitempty"`
+	// Types that are assignable to Source:
+	//	*StreamTweetsRequest_Search
+	//	*StreamTweetsRequest_MentionTimeline
+	//	*StreamTweetsRequest_UserTimeline
+	Source isStreamTweetsRequest_Source `protobuf_oneof:"source"`
+	/// The minimum number of seconds to wait between polls. The proxy may wait for longer than this in order
+	/// to avoid exhausting the rate limit
+	MinIntervalSeconds uint32 `protobuf:"varint,4,opt,name=min_interval_seconds,json=minIntervalSeconds,proto3" json:"min_interval_seconds,omitempty"`
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
+func (x *StreamTweetsRequest) Reset() {
+	*x = StreamTweetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[16]
+		mi := &file_twitter1_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProfileRequest) String() string {
+func (x *StreamTweetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (*StreamTweetsRequest) ProtoMessage() {}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[16]
+func (x *StreamTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,95 +1541,98 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use StreamTweetsRequest.ProtoReflect.Descriptor instead.
+func (*StreamTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateProfileRequest) GetAuth() *Authentication {
-	if x != nil {
-		return x.Auth
+func (m *StreamTweetsRequest) GetSource() isStreamTweetsRequest_Source {
+	if m != nil {
+		return m.Source
 	}
 	return nil
 }
 
-func (x *UpdateProfileRequest) GetName() *OptString {
-	if x != nil {
-		return x.Name
+func (x *StreamTweetsRequest) GetSearch() *SearchRequest {
+	if x, ok := x.GetSource().(*StreamTweetsRequest_Search); ok {
+		return x.Search
 	}
 	return nil
 }
 
-func (x *UpdateProfileRequest) GetUrl() *OptString {
-	if x != nil {
-		return x.Url
+func (x *StreamTweetsRequest) GetMentionTimeline() *MentionTimelineRequest {
+	if x, ok := x.GetSource().(*StreamTweetsRequest_MentionTimeline); ok {
+		return x.MentionTimeline
 	}
 	return nil
 }
 
-func (x *UpdateProfileRequest) GetLocation() *OptString {
-	if x != nil {
-		return x.Location
+func (x *StreamTweetsRequest) GetUserTimeline() *UserTimelineRequest {
+	if x, ok := x.GetSource().(*StreamTweetsRequest_UserTimeline); ok {
+		return x.UserTimeline
 	}
 	return nil
 }
 
-func (x *UpdateProfileRequest) GetBio() *OptString {
+func (x *StreamTweetsRequest) GetMinIntervalSeconds() uint32 {
 	if x != nil {
-		return x.Bio
+		return x.MinIntervalSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateProfileRequest) GetLinkColor() *OptString {
-	if x != nil {
-		return x.LinkColor
-	}
-	return nil
+type isStreamTweetsRequest_Source interface {
+	isStreamTweetsRequest_Source()
 }
 
-func (x *UpdateProfileRequest) GetIncludeEntities() bool {
-	if x != nil {
-		return x.IncludeEntities
-	}
-	return false
+type StreamTweetsRequest_Search struct {
+	Search *SearchRequest `protobuf:"bytes,1,opt,name=search,proto3,oneof"`
 }
 
-func (x *UpdateProfileRequest) GetIncludeStatuses() bool {
-	if x != nil {
-		return x.IncludeStatuses
-	}
-	return false
+type StreamTweetsRequest_MentionTimeline struct {
+	MentionTimeline *MentionTimelineRequest `protobuf:"bytes,2,opt,name=mention_timeline,json=mentionTimeline,proto3,oneof"`
 }
 
-type TweetResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type StreamTweetsRequest_UserTimeline struct {
+	UserTimeline *UserTimelineRequest `protobuf:"bytes,3,opt,name=user_timeline,json=userTimeline,proto3,oneof"`
+}
 
-	// Types that are assignable to Response:
-	//	*TweetResponse_Tweet
-	//	*TweetResponse_Error
-	Response isTweetResponse_Response `protobuf_oneof:"response"`
+func (*StreamTweetsRequest_Search) isStreamTweetsRequest_Source() {}
+
+func (*StreamTweetsRequest_MentionTimeline) isStreamTweetsRequest_Source() {}
+
+func (*StreamTweetsRequest_UserTimeline) isStreamTweetsRequest_Source() {}
+
+type UserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Types that are assignable to User:
+	//	*UserRequest_UserId
+	//	*UserRequest_UserHandle
+	User            isUserRequest_User `protobuf_oneof:"user"`
+	IncludeEntities bool               `protobuf:"varint,4,opt,name=include_entities,json=includeEntities,proto3" json:"include_entities,omitempty"`
 }
 
-func (x *TweetResponse) Reset() {
-	*x = TweetResponse{}
+func (x *UserRequest) Reset() {
+	*x = UserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[17]
+		mi := &file_twitter1_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TweetResponse) String() string {
+func (x *UserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TweetResponse) ProtoMessage() {}
+func (*UserRequest) ProtoMessage() {}
 
-func (x *TweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[17]
+func (x *UserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,76 +1643,96 @@ func (x *TweetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TweetResponse.ProtoReflect.Descriptor instead.
-func (*TweetResponse) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
+func (*UserRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *TweetResponse) GetResponse() isTweetResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *UserRequest) GetAuth() *Authentication {
+	if x != nil {
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *TweetResponse) GetTweet() *Tweet {
-	if x, ok := x.GetResponse().(*TweetResponse_Tweet); ok {
-		return x.Tweet
+func (m *UserRequest) GetUser() isUserRequest_User {
+	if m != nil {
+		return m.User
 	}
 	return nil
 }
 
-func (x *TweetResponse) GetError() *Error {
-	if x, ok := x.GetResponse().(*TweetResponse_Error); ok {
-		return x.Error
+func (x *UserRequest) GetUserId() uint64 {
+	if x, ok := x.GetUser().(*UserRequest_UserId); ok {
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type isTweetResponse_Response interface {
-	isTweetResponse_Response()
+func (x *UserRequest) GetUserHandle() string {
+	if x, ok := x.GetUser().(*UserRequest_UserHandle); ok {
+		return x.UserHandle
+	}
+	return ""
 }
 
-type TweetResponse_Tweet struct {
-	Tweet *Tweet `protobuf:"bytes,1,opt,name=tweet,proto3,oneof"`
+func (x *UserRequest) GetIncludeEntities() bool {
+	if x != nil {
+		return x.IncludeEntities
+	}
+	return false
 }
 
-type TweetResponse_Error struct {
-	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+type isUserRequest_User interface {
+	isUserRequest_User()
 }
 
-func (*TweetResponse_Tweet) isTweetResponse_Response() {}
+type UserRequest_UserId struct {
+	UserId uint64 `protobuf:"fixed64,2,opt,name=user_id,json=userId,proto3,oneof"`
+}
 
-func (*TweetResponse_Error) isTweetResponse_Response() {}
+type UserRequest_UserHandle struct {
+	UserHandle string `protobuf:"bytes,3,opt,name=user_handle,json=userHandle,proto3,oneof"`
+}
 
-type TweetsResponse struct {
+func (*UserRequest_UserId) isUserRequest_User() {}
+
+func (*UserRequest_UserHandle) isUserRequest_User() {}
+
+type UserGraphRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//	*TweetsResponse_Tweets
-	//	*TweetsResponse_Error
-	Response isTweetsResponse_Response `protobuf_oneof:"response"`
-}
-
-func (x *TweetsResponse) Reset() {
-	*x = TweetsResponse{}
+	Auth *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Types that are assignable to User:
+	//	*UserGraphRequest_UserId
+	//	*UserGraphRequest_UserHandle
+	User          isUserGraphRequest_User `protobuf_oneof:"user"`
+	CursorOptions *CursorOptions          `protobuf:"bytes,4,opt,name=cursor_options,json=cursorOptions,proto3" json:"cursor_options,omitempty"`
+	/// Only used when full user objects are returned
+	IncludeStatuses bool `protobuf:"varint,5,opt,name=include_statuses,json=includeStatuses,proto3" json:"include_statuses,omitempty"`
+	/// Only used when full user objects are returned
+	IncludeEntities bool `protobuf:"varint,6,opt,name=include_entities,json=includeEntities,proto3" json:"include_entities,omitempty"`
+}
+
+func (x *UserGraphRequest) Reset() {
+	*x = UserGraphRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[18]
+		mi := &file_twitter1_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TweetsResponse) String() string {
+func (x *UserGraphRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TweetsResponse) ProtoMessage() {}
+func (*UserGraphRequest) ProtoMessage() {}
 
-func (x *TweetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[18]
+func (x *UserGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1512,76 +1743,105 @@ func (x *TweetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TweetsResponse.ProtoReflect.Descriptor instead.
-func (*TweetsResponse) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UserGraphRequest.ProtoReflect.Descriptor instead.
+func (*UserGraphRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{20}
 }
 
-func (m *TweetsResponse) GetResponse() isTweetsResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *UserGraphRequest) GetAuth() *Authentication {
+	if x != nil {
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *TweetsResponse) GetTweets() *Tweets {
-	if x, ok := x.GetResponse().(*TweetsResponse_Tweets); ok {
-		return x.Tweets
+func (m *UserGraphRequest) GetUser() isUserGraphRequest_User {
+	if m != nil {
+		return m.User
 	}
 	return nil
 }
 
-func (x *TweetsResponse) GetError() *Error {
-	if x, ok := x.GetResponse().(*TweetsResponse_Error); ok {
-		return x.Error
+func (x *UserGraphRequest) GetUserId() uint64 {
+	if x, ok := x.GetUser().(*UserGraphRequest_UserId); ok {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserGraphRequest) GetUserHandle() string {
+	if x, ok := x.GetUser().(*UserGraphRequest_UserHandle); ok {
+		return x.UserHandle
+	}
+	return ""
+}
+
+func (x *UserGraphRequest) GetCursorOptions() *CursorOptions {
+	if x != nil {
+		return x.CursorOptions
 	}
 	return nil
 }
 
-type isTweetsResponse_Response interface {
-	isTweetsResponse_Response()
+func (x *UserGraphRequest) GetIncludeStatuses() bool {
+	if x != nil {
+		return x.IncludeStatuses
+	}
+	return false
 }
 
-type TweetsResponse_Tweets struct {
-	Tweets *Tweets `protobuf:"bytes,1,opt,name=tweets,proto3,oneof"`
+func (x *UserGraphRequest) GetIncludeEntities() bool {
+	if x != nil {
+		return x.IncludeEntities
+	}
+	return false
 }
 
-type TweetsResponse_Error struct {
-	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+type isUserGraphRequest_User interface {
+	isUserGraphRequest_User()
 }
 
-func (*TweetsResponse_Tweets) isTweetsResponse_Response() {}
+type UserGraphRequest_UserId struct {
+	UserId uint64 `protobuf:"fixed64,2,opt,name=user_id,json=userId,proto3,oneof"`
+}
 
-func (*TweetsResponse_Error) isTweetsResponse_Response() {}
+type UserGraphRequest_UserHandle struct {
+	UserHandle string `protobuf:"bytes,3,opt,name=user_handle,json=userHandle,proto3,oneof"`
+}
 
-type UserResponse struct {
+func (*UserGraphRequest_UserId) isUserGraphRequest_User() {}
+
+func (*UserGraphRequest_UserHandle) isUserGraphRequest_User() {}
+
+// / Up to 100 users may be requested in total
+type UsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//	*UserResponse_User
-	//	*UserResponse_Error
-	Response isUserResponse_Response `protobuf_oneof:"response"`
+	Auth            *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	UserIds         []uint64        `protobuf:"fixed64,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	UserHandles     []string        `protobuf:"bytes,3,rep,name=user_handles,json=userHandles,proto3" json:"user_handles,omitempty"`
+	IncludeEntities bool            `protobuf:"varint,4,opt,name=include_entities,json=includeEntities,proto3" json:"include_entities,omitempty"`
 }
 
-func (x *UserResponse) Reset() {
-	*x = UserResponse{}
+func (x *UsersRequest) Reset() {
+	*x = UsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[19]
+		mi := &file_twitter1_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserResponse) String() string {
+func (x *UsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserResponse) ProtoMessage() {}
+func (*UsersRequest) ProtoMessage() {}
 
-func (x *UserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[19]
+func (x *UsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,73 +1852,71 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
-func (*UserResponse) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UsersRequest.ProtoReflect.Descriptor instead.
+func (*UsersRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{21}
 }
 
-func (m *UserResponse) GetResponse() isUserResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *UsersRequest) GetAuth() *Authentication {
+	if x != nil {
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *UserResponse) GetUser() *User {
-	if x, ok := x.GetResponse().(*UserResponse_User); ok {
-		return x.User
+func (x *UsersRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
 	}
 	return nil
 }
 
-func (x *UserResponse) GetError() *Error {
-	if x, ok := x.GetResponse().(*UserResponse_Error); ok {
-		return x.Error
+func (x *UsersRequest) GetUserHandles() []string {
+	if x != nil {
+		return x.UserHandles
 	}
 	return nil
 }
 
-type isUserResponse_Response interface {
-	isUserResponse_Response()
-}
-
-type UserResponse_User struct {
-	User *User `protobuf:"bytes,1,opt,name=user,proto3,oneof"`
-}
-
-type UserResponse_Error struct {
-	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (x *UsersRequest) GetIncludeEntities() bool {
+	if x != nil {
+		return x.IncludeEntities
+	}
+	return false
 }
 
-func (*UserResponse_User) isUserResponse_Response() {}
-
-func (*UserResponse_Error) isUserResponse_Response() {}
-
-type Tweets struct {
+type UpdateProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tweets []*Tweet `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	Auth            *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	Name            *OptString      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url             *OptString      `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Location        *OptString      `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Bio             *OptString      `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	LinkColor       *OptString      `protobuf:"bytes,6,opt,name=link_color,json=linkColor,proto3" json:"link_color,omitempty"`
+	IncludeEntities bool            `protobuf:"varint,7,opt,name=include_entities,json=includeEntities,proto3" json:"include_entities,omitempty"`
+	IncludeStatuses bool            `protobuf:"varint,8,opt,name=include_statuses,json=includeStatuses,proto3" json:"include_statuses,omitempty"`
 }
 
-func (x *Tweets) Reset() {
-	*x = Tweets{}
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[20]
+		mi := &file_twitter1_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Tweets) String() string {
+func (x *UpdateProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tweets) ProtoMessage() {}
+func (*UpdateProfileRequest) ProtoMessage() {}
 
-func (x *Tweets) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[20]
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,335 +1927,288 @@ func (x *Tweets) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tweets.ProtoReflect.Descriptor instead.
-func (*Tweets) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Tweets) GetTweets() []*Tweet {
+func (x *UpdateProfileRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.Tweets
+		return x.Auth
 	}
 	return nil
 }
 
-type Tweet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id                   uint64           `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt            int64            `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Text                 string           `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
-	TextDisplayRange     *Indices         `protobuf:"bytes,4,opt,name=text_display_range,json=textDisplayRange,proto3" json:"text_display_range,omitempty"`
-	Truncated            bool             `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
-	Source               string           `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
-	User                 *User            `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
-	RepliedTweet         *Tweet_ReplyData `protobuf:"bytes,8,opt,name=replied_tweet,json=repliedTweet,proto3" json:"replied_tweet,omitempty"`
-	QuotedTweet          *Tweet           `protobuf:"bytes,9,opt,name=quoted_tweet,json=quotedTweet,proto3" json:"quoted_tweet,omitempty"`
-	RetweetedTweet       *Tweet           `protobuf:"bytes,10,opt,name=retweeted_tweet,json=retweetedTweet,proto3" json:"retweeted_tweet,omitempty"`
-	QuoteCount           uint32           `protobuf:"varint,11,opt,name=quote_count,json=quoteCount,proto3" json:"quote_count,omitempty"`
-	ReplyCount           uint32           `protobuf:"varint,12,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
-	RetweetCount         uint32           `protobuf:"varint,13,opt,name=retweet_count,json=retweetCount,proto3" json:"retweet_count,omitempty"`
-	FavoriteCount        uint32           `protobuf:"varint,14,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
-	Favorited            bool             `protobuf:"varint,15,opt,name=favorited,proto3" json:"favorited,omitempty"`
-	Retweeted            bool             `protobuf:"varint,16,opt,name=retweeted,proto3" json:"retweeted,omitempty"`
-	CurrentUserRetweetId *OptFixed64      `protobuf:"bytes,17,opt,name=current_user_retweet_id,json=currentUserRetweetId,proto3" json:"current_user_retweet_id,omitempty"`
-	Hashtags             []*Symbol        `protobuf:"bytes,18,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
-	Urls                 []*URL           `protobuf:"bytes,19,rep,name=urls,proto3" json:"urls,omitempty"`
-	Mentions             []*Mention       `protobuf:"bytes,20,rep,name=mentions,proto3" json:"mentions,omitempty"`
-	Symbols              []*Symbol        `protobuf:"bytes,21,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	Media                []*Media         `protobuf:"bytes,22,rep,name=media,proto3" json:"media,omitempty"`
-	Polls                []*Poll          `protobuf:"bytes,23,rep,name=polls,proto3" json:"polls,omitempty"`
-	PossiblySensitive    bool             `protobuf:"varint,24,opt,name=possibly_sensitive,json=possiblySensitive,proto3" json:"possibly_sensitive,omitempty"`
-	FilterLevel          string           `protobuf:"bytes,25,opt,name=filter_level,json=filterLevel,proto3" json:"filter_level,omitempty"`
-	Lang                 string           `protobuf:"bytes,26,opt,name=lang,proto3" json:"lang,omitempty"`
-	WithheldCopyright    bool             `protobuf:"varint,27,opt,name=withheld_copyright,json=withheldCopyright,proto3" json:"withheld_copyright,omitempty"`
-	WithheldCountries    []string         `protobuf:"bytes,28,rep,name=withheld_countries,json=withheldCountries,proto3" json:"withheld_countries,omitempty"`
-	WithheldScope        string           `protobuf:"bytes,29,opt,name=withheld_scope,json=withheldScope,proto3" json:"withheld_scope,omitempty"`
+func (x *UpdateProfileRequest) GetName() *OptString {
+	if x != nil {
+		return x.Name
+	}
+	return nil
 }
 
-func (x *Tweet) Reset() {
-	*x = Tweet{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Tweet) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Tweet) ProtoMessage() {}
-
-func (x *Tweet) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Tweet.ProtoReflect.Descriptor instead.
-func (*Tweet) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *Tweet) GetId() uint64 {
+func (x *UpdateProfileRequest) GetUrl() *OptString {
 	if x != nil {
-		return x.Id
+		return x.Url
 	}
-	return 0
+	return nil
 }
 
-func (x *Tweet) GetCreatedAt() int64 {
+func (x *UpdateProfileRequest) GetLocation() *OptString {
 	if x != nil {
-		return x.CreatedAt
+		return x.Location
 	}
-	return 0
+	return nil
 }
 
-func (x *Tweet) GetText() string {
+func (x *UpdateProfileRequest) GetBio() *OptString {
 	if x != nil {
-		return x.Text
+		return x.Bio
 	}
-	return ""
+	return nil
 }
 
-func (x *Tweet) GetTextDisplayRange() *Indices {
+func (x *UpdateProfileRequest) GetLinkColor() *OptString {
 	if x != nil {
-		return x.TextDisplayRange
+		return x.LinkColor
 	}
 	return nil
 }
 
-func (x *Tweet) GetTruncated() bool {
+func (x *UpdateProfileRequest) GetIncludeEntities() bool {
 	if x != nil {
-		return x.Truncated
+		return x.IncludeEntities
 	}
 	return false
 }
 
-func (x *Tweet) GetSource() string {
+func (x *UpdateProfileRequest) GetIncludeStatuses() bool {
 	if x != nil {
-		return x.Source
+		return x.IncludeStatuses
 	}
-	return ""
+	return false
 }
 
-func (x *Tweet) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+type SendDirectMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth        *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	RecipientId uint64          `protobuf:"fixed64,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Text        string          `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	/// The id of previously uploaded media to attach to the message
+	MediaId *OptFixed64 `protobuf:"bytes,4,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
 }
 
-func (x *Tweet) GetRepliedTweet() *Tweet_ReplyData {
-	if x != nil {
-		return x.RepliedTweet
+func (x *SendDirectMessageRequest) Reset() {
+	*x = SendDirectMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Tweet) GetQuotedTweet() *Tweet {
-	if x != nil {
-		return x.QuotedTweet
-	}
-	return nil
+func (x *SendDirectMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Tweet) GetRetweetedTweet() *Tweet {
-	if x != nil {
-		return x.RetweetedTweet
+func (*SendDirectMessageRequest) ProtoMessage() {}
+
+func (x *SendDirectMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Tweet) GetQuoteCount() uint32 {
-	if x != nil {
-		return x.QuoteCount
-	}
-	return 0
+// Deprecated: Use SendDirectMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendDirectMessageRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Tweet) GetReplyCount() uint32 {
+func (x *SendDirectMessageRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.ReplyCount
+		return x.Auth
 	}
-	return 0
+	return nil
 }
 
-func (x *Tweet) GetRetweetCount() uint32 {
+func (x *SendDirectMessageRequest) GetRecipientId() uint64 {
 	if x != nil {
-		return x.RetweetCount
+		return x.RecipientId
 	}
 	return 0
 }
 
-func (x *Tweet) GetFavoriteCount() uint32 {
+func (x *SendDirectMessageRequest) GetText() string {
 	if x != nil {
-		return x.FavoriteCount
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-func (x *Tweet) GetFavorited() bool {
+func (x *SendDirectMessageRequest) GetMediaId() *OptFixed64 {
 	if x != nil {
-		return x.Favorited
+		return x.MediaId
 	}
-	return false
+	return nil
 }
 
-func (x *Tweet) GetRetweeted() bool {
-	if x != nil {
-		return x.Retweeted
-	}
-	return false
+// / Direct messages from the last 30 days are returned, newest first
+type ListDirectMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth  *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	Count uint32          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	/// The cursor of the page to get, as given by next_cursor of another page. The first page is returned if
+	/// this is empty
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *Tweet) GetCurrentUserRetweetId() *OptFixed64 {
-	if x != nil {
-		return x.CurrentUserRetweetId
+func (x *ListDirectMessagesRequest) Reset() {
+	*x = ListDirectMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Tweet) GetHashtags() []*Symbol {
-	if x != nil {
-		return x.Hashtags
-	}
-	return nil
+func (x *ListDirectMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Tweet) GetUrls() []*URL {
-	if x != nil {
-		return x.Urls
+func (*ListDirectMessagesRequest) ProtoMessage() {}
+
+func (x *ListDirectMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Tweet) GetMentions() []*Mention {
-	if x != nil {
-		return x.Mentions
-	}
-	return nil
+// Deprecated: Use ListDirectMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Tweet) GetSymbols() []*Symbol {
+func (x *ListDirectMessagesRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.Symbols
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *Tweet) GetMedia() []*Media {
+func (x *ListDirectMessagesRequest) GetCount() uint32 {
 	if x != nil {
-		return x.Media
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-func (x *Tweet) GetPolls() []*Poll {
+func (x *ListDirectMessagesRequest) GetCursor() string {
 	if x != nil {
-		return x.Polls
+		return x.Cursor
 	}
-	return nil
+	return ""
 }
 
-func (x *Tweet) GetPossiblySensitive() bool {
-	if x != nil {
-		return x.PossiblySensitive
-	}
-	return false
+type ListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth   *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	ListId uint64          `protobuf:"fixed64,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
 }
 
-func (x *Tweet) GetFilterLevel() string {
-	if x != nil {
-		return x.FilterLevel
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Tweet) GetLang() string {
-	if x != nil {
-		return x.Lang
-	}
-	return ""
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Tweet) GetWithheldCopyright() bool {
-	if x != nil {
-		return x.WithheldCopyright
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Tweet) GetWithheldCountries() []string {
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.WithheldCountries
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *Tweet) GetWithheldScope() string {
+func (x *ListRequest) GetListId() uint64 {
 	if x != nil {
-		return x.WithheldScope
+		return x.ListId
 	}
-	return ""
+	return 0
 }
 
-type User struct {
+type ListTimelineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  uint64   `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
-	Handle              string   `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
-	DisplayName         string   `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	CreatedAt           int64    `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Bio                 string   `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
-	Url                 string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	Location            string   `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
-	Protected           bool     `protobuf:"varint,8,opt,name=protected,proto3" json:"protected,omitempty"`
-	Verified            bool     `protobuf:"varint,9,opt,name=verified,proto3" json:"verified,omitempty"`
-	FollowerCount       uint32   `protobuf:"varint,10,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
-	FollowingCount      uint32   `protobuf:"varint,11,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
-	ListedCount         uint32   `protobuf:"varint,12,opt,name=listed_count,json=listedCount,proto3" json:"listed_count,omitempty"`
-	FavoritesCount      uint32   `protobuf:"varint,13,opt,name=favorites_count,json=favoritesCount,proto3" json:"favorites_count,omitempty"`
-	StatusesCount       uint32   `protobuf:"varint,14,opt,name=statuses_count,json=statusesCount,proto3" json:"statuses_count,omitempty"`
-	ProfileBanner       string   `protobuf:"bytes,15,opt,name=profile_banner,json=profileBanner,proto3" json:"profile_banner,omitempty"`
-	ProfileImage        string   `protobuf:"bytes,16,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
-	DefaultProfile      bool     `protobuf:"varint,17,opt,name=default_profile,json=defaultProfile,proto3" json:"default_profile,omitempty"`
-	DefaultProfileImage bool     `protobuf:"varint,18,opt,name=default_profile_image,json=defaultProfileImage,proto3" json:"default_profile_image,omitempty"`
-	WithheldCountries   []string `protobuf:"bytes,19,rep,name=withheld_countries,json=withheldCountries,proto3" json:"withheld_countries,omitempty"`
-	WithheldScope       string   `protobuf:"bytes,20,opt,name=withheld_scope,json=withheldScope,proto3" json:"withheld_scope,omitempty"`
-	UrlUrls             []*URL   `protobuf:"bytes,21,rep,name=url_urls,json=urlUrls,proto3" json:"url_urls,omitempty"`
-	BioUrls             []*URL   `protobuf:"bytes,22,rep,name=bio_urls,json=bioUrls,proto3" json:"bio_urls,omitempty"`
+	Auth            *Authentication  `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	ListId          uint64           `protobuf:"fixed64,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	TimelineOptions *TimelineOptions `protobuf:"bytes,3,opt,name=timeline_options,json=timelineOptions,proto3" json:"timeline_options,omitempty"`
+	IncludeRetweets bool             `protobuf:"varint,4,opt,name=include_retweets,json=includeRetweets,proto3" json:"include_retweets,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *ListTimelineRequest) Reset() {
+	*x = ListTimelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[22]
+		mi := &file_twitter1_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *User) String() string {
+func (x *ListTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*ListTimelineRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[22]
+func (x *ListTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,193 +2219,245 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListTimelineRequest.ProtoReflect.Descriptor instead.
+func (*ListTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *User) GetId() uint64 {
+func (x *ListTimelineRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.Id
+		return x.Auth
 	}
-	return 0
+	return nil
 }
 
-func (x *User) GetHandle() string {
+func (x *ListTimelineRequest) GetListId() uint64 {
 	if x != nil {
-		return x.Handle
+		return x.ListId
 	}
-	return ""
+	return 0
 }
 
-func (x *User) GetDisplayName() string {
+func (x *ListTimelineRequest) GetTimelineOptions() *TimelineOptions {
 	if x != nil {
-		return x.DisplayName
+		return x.TimelineOptions
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetCreatedAt() int64 {
+func (x *ListTimelineRequest) GetIncludeRetweets() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.IncludeRetweets
 	}
-	return 0
+	return false
 }
 
-func (x *User) GetBio() string {
-	if x != nil {
-		return x.Bio
-	}
-	return ""
+type ListMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth            *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	ListId          uint64          `protobuf:"fixed64,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	CursorOptions   *CursorOptions  `protobuf:"bytes,3,opt,name=cursor_options,json=cursorOptions,proto3" json:"cursor_options,omitempty"`
+	IncludeStatuses bool            `protobuf:"varint,4,opt,name=include_statuses,json=includeStatuses,proto3" json:"include_statuses,omitempty"`
+	IncludeEntities bool            `protobuf:"varint,5,opt,name=include_entities,json=includeEntities,proto3" json:"include_entities,omitempty"`
 }
 
-func (x *User) GetUrl() string {
-	if x != nil {
-		return x.Url
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *User) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetProtected() bool {
-	if x != nil {
-		return x.Protected
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetVerified() bool {
-	if x != nil {
-		return x.Verified
-	}
-	return false
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *User) GetFollowerCount() uint32 {
+func (x *ListMembersRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.FollowerCount
+		return x.Auth
 	}
-	return 0
+	return nil
 }
 
-func (x *User) GetFollowingCount() uint32 {
+func (x *ListMembersRequest) GetListId() uint64 {
 	if x != nil {
-		return x.FollowingCount
+		return x.ListId
 	}
 	return 0
 }
 
-func (x *User) GetListedCount() uint32 {
+func (x *ListMembersRequest) GetCursorOptions() *CursorOptions {
 	if x != nil {
-		return x.ListedCount
+		return x.CursorOptions
 	}
-	return 0
+	return nil
 }
 
-func (x *User) GetFavoritesCount() uint32 {
+func (x *ListMembersRequest) GetIncludeStatuses() bool {
 	if x != nil {
-		return x.FavoritesCount
+		return x.IncludeStatuses
 	}
-	return 0
+	return false
 }
 
-func (x *User) GetStatusesCount() uint32 {
+func (x *ListMembersRequest) GetIncludeEntities() bool {
 	if x != nil {
-		return x.StatusesCount
+		return x.IncludeEntities
 	}
-	return 0
+	return false
 }
 
-func (x *User) GetProfileBanner() string {
-	if x != nil {
-		return x.ProfileBanner
-	}
-	return ""
+type ListMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth   *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	ListId uint64          `protobuf:"fixed64,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	// Types that are assignable to User:
+	//	*ListMemberRequest_UserId
+	//	*ListMemberRequest_UserHandle
+	User isListMemberRequest_User `protobuf_oneof:"user"`
 }
 
-func (x *User) GetProfileImage() string {
-	if x != nil {
-		return x.ProfileImage
+func (x *ListMemberRequest) Reset() {
+	*x = ListMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *User) GetDefaultProfile() bool {
-	if x != nil {
-		return x.DefaultProfile
-	}
-	return false
+func (x *ListMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetDefaultProfileImage() bool {
-	if x != nil {
-		return x.DefaultProfileImage
+func (*ListMemberRequest) ProtoMessage() {}
+
+func (x *ListMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetWithheldCountries() []string {
+// Deprecated: Use ListMemberRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMemberRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.WithheldCountries
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *User) GetWithheldScope() string {
+func (x *ListMemberRequest) GetListId() uint64 {
 	if x != nil {
-		return x.WithheldScope
+		return x.ListId
 	}
-	return ""
+	return 0
 }
 
-func (x *User) GetUrlUrls() []*URL {
-	if x != nil {
-		return x.UrlUrls
+func (m *ListMemberRequest) GetUser() isListMemberRequest_User {
+	if m != nil {
+		return m.User
 	}
 	return nil
 }
 
-func (x *User) GetBioUrls() []*URL {
-	if x != nil {
-		return x.BioUrls
+func (x *ListMemberRequest) GetUserId() uint64 {
+	if x, ok := x.GetUser().(*ListMemberRequest_UserId); ok {
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type URL struct {
+func (x *ListMemberRequest) GetUserHandle() string {
+	if x, ok := x.GetUser().(*ListMemberRequest_UserHandle); ok {
+		return x.UserHandle
+	}
+	return ""
+}
+
+type isListMemberRequest_User interface {
+	isListMemberRequest_User()
+}
+
+type ListMemberRequest_UserId struct {
+	UserId uint64 `protobuf:"fixed64,3,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type ListMemberRequest_UserHandle struct {
+	UserHandle string `protobuf:"bytes,4,opt,name=user_handle,json=userHandle,proto3,oneof"`
+}
+
+func (*ListMemberRequest_UserId) isListMemberRequest_User() {}
+
+func (*ListMemberRequest_UserHandle) isListMemberRequest_User() {}
+
+// / The authenticated user's lists are returned if no user is given
+type OwnedListsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Indices     *Indices `protobuf:"bytes,1,opt,name=indices,proto3" json:"indices,omitempty"`
-	TwitterUrl  string   `protobuf:"bytes,2,opt,name=twitter_url,json=twitterUrl,proto3" json:"twitter_url,omitempty"`
-	DisplayUrl  string   `protobuf:"bytes,3,opt,name=display_url,json=displayUrl,proto3" json:"display_url,omitempty"`
-	ExpandedUrl string   `protobuf:"bytes,4,opt,name=expanded_url,json=expandedUrl,proto3" json:"expanded_url,omitempty"`
+	Auth *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Types that are assignable to User:
+	//	*OwnedListsRequest_UserId
+	//	*OwnedListsRequest_UserHandle
+	User          isOwnedListsRequest_User `protobuf_oneof:"user"`
+	CursorOptions *CursorOptions           `protobuf:"bytes,4,opt,name=cursor_options,json=cursorOptions,proto3" json:"cursor_options,omitempty"`
 }
 
-func (x *URL) Reset() {
-	*x = URL{}
+func (x *OwnedListsRequest) Reset() {
+	*x = OwnedListsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[23]
+		mi := &file_twitter1_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *URL) String() string {
+func (x *OwnedListsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*URL) ProtoMessage() {}
+func (*OwnedListsRequest) ProtoMessage() {}
 
-func (x *URL) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[23]
+func (x *OwnedListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2205,65 +2468,90 @@ func (x *URL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use URL.ProtoReflect.Descriptor instead.
-func (*URL) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use OwnedListsRequest.ProtoReflect.Descriptor instead.
+func (*OwnedListsRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *URL) GetIndices() *Indices {
+func (x *OwnedListsRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.Indices
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *URL) GetTwitterUrl() string {
-	if x != nil {
-		return x.TwitterUrl
+func (m *OwnedListsRequest) GetUser() isOwnedListsRequest_User {
+	if m != nil {
+		return m.User
 	}
-	return ""
+	return nil
 }
 
-func (x *URL) GetDisplayUrl() string {
-	if x != nil {
-		return x.DisplayUrl
+func (x *OwnedListsRequest) GetUserId() uint64 {
+	if x, ok := x.GetUser().(*OwnedListsRequest_UserId); ok {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OwnedListsRequest) GetUserHandle() string {
+	if x, ok := x.GetUser().(*OwnedListsRequest_UserHandle); ok {
+		return x.UserHandle
 	}
 	return ""
 }
 
-func (x *URL) GetExpandedUrl() string {
+func (x *OwnedListsRequest) GetCursorOptions() *CursorOptions {
 	if x != nil {
-		return x.ExpandedUrl
+		return x.CursorOptions
 	}
-	return ""
+	return nil
 }
 
-type Symbol struct {
+type isOwnedListsRequest_User interface {
+	isOwnedListsRequest_User()
+}
+
+type OwnedListsRequest_UserId struct {
+	UserId uint64 `protobuf:"fixed64,2,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type OwnedListsRequest_UserHandle struct {
+	UserHandle string `protobuf:"bytes,3,opt,name=user_handle,json=userHandle,proto3,oneof"`
+}
+
+func (*OwnedListsRequest_UserId) isOwnedListsRequest_User() {}
+
+func (*OwnedListsRequest_UserHandle) isOwnedListsRequest_User() {}
+
+type UploadMediaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Indices *Indices `protobuf:"bytes,1,opt,name=indices,proto3" json:"indices,omitempty"`
-	Text    string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Auth             *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	Media            []byte          `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"`
+	MediaCategory    string          `protobuf:"bytes,3,opt,name=media_category,json=mediaCategory,proto3" json:"media_category,omitempty"`
+	AdditionalOwners []uint64        `protobuf:"fixed64,4,rep,packed,name=additional_owners,json=additionalOwners,proto3" json:"additional_owners,omitempty"`
 }
 
-func (x *Symbol) Reset() {
-	*x = Symbol{}
+func (x *UploadMediaRequest) Reset() {
+	*x = UploadMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[24]
+		mi := &file_twitter1_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Symbol) String() string {
+func (x *UploadMediaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Symbol) ProtoMessage() {}
+func (*UploadMediaRequest) ProtoMessage() {}
 
-func (x *Symbol) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[24]
+func (x *UploadMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,53 +2562,69 @@ func (x *Symbol) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Symbol.ProtoReflect.Descriptor instead.
-func (*Symbol) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use UploadMediaRequest.ProtoReflect.Descriptor instead.
+func (*UploadMediaRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Symbol) GetIndices() *Indices {
+func (x *UploadMediaRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.Indices
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *Symbol) GetText() string {
+func (x *UploadMediaRequest) GetMedia() []byte {
 	if x != nil {
-		return x.Text
+		return x.Media
+	}
+	return nil
+}
+
+func (x *UploadMediaRequest) GetMediaCategory() string {
+	if x != nil {
+		return x.MediaCategory
 	}
 	return ""
 }
 
-type Mention struct {
+func (x *UploadMediaRequest) GetAdditionalOwners() []uint64 {
+	if x != nil {
+		return x.AdditionalOwners
+	}
+	return nil
+}
+
+// / The first message of a chunked upload must be an Init; each following message contains the data of one
+// / segment, which must be no larger than 5MB
+type MediaChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Indices     *Indices `protobuf:"bytes,1,opt,name=indices,proto3" json:"indices,omitempty"`
-	UserId      uint64   `protobuf:"fixed64,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Handle      string   `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
-	DisplayName string   `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Types that are assignable to Chunk:
+	//	*MediaChunk_Init_
+	//	*MediaChunk_Data
+	Chunk isMediaChunk_Chunk `protobuf_oneof:"chunk"`
 }
 
-func (x *Mention) Reset() {
-	*x = Mention{}
+func (x *MediaChunk) Reset() {
+	*x = MediaChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[25]
+		mi := &file_twitter1_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Mention) String() string {
+func (x *MediaChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Mention) ProtoMessage() {}
+func (*MediaChunk) ProtoMessage() {}
 
-func (x *Mention) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[25]
+func (x *MediaChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2331,73 +2635,74 @@ func (x *Mention) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
-func (*Mention) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MediaChunk.ProtoReflect.Descriptor instead.
+func (*MediaChunk) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Mention) GetIndices() *Indices {
-	if x != nil {
-		return x.Indices
+func (m *MediaChunk) GetChunk() isMediaChunk_Chunk {
+	if m != nil {
+		return m.Chunk
 	}
 	return nil
 }
 
-func (x *Mention) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
+func (x *MediaChunk) GetInit() *MediaChunk_Init {
+	if x, ok := x.GetChunk().(*MediaChunk_Init_); ok {
+		return x.Init
 	}
-	return 0
+	return nil
 }
 
-func (x *Mention) GetHandle() string {
-	if x != nil {
-		return x.Handle
+func (x *MediaChunk) GetData() []byte {
+	if x, ok := x.GetChunk().(*MediaChunk_Data); ok {
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *Mention) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+type isMediaChunk_Chunk interface {
+	isMediaChunk_Chunk()
 }
 
-type Media struct {
+type MediaChunk_Init_ struct {
+	Init *MediaChunk_Init `protobuf:"bytes,1,opt,name=init,proto3,oneof"`
+}
+
+type MediaChunk_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*MediaChunk_Init_) isMediaChunk_Chunk() {}
+
+func (*MediaChunk_Data) isMediaChunk_Chunk() {}
+
+type MediaStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url           *URL        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Id            uint64      `protobuf:"fixed64,2,opt,name=id,proto3" json:"id,omitempty"`
-	Type          string      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	MediaUrl      string      `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
-	Alt           string      `protobuf:"bytes,5,opt,name=alt,proto3" json:"alt,omitempty"`
-	SourceTweetId *OptFixed64 `protobuf:"bytes,6,opt,name=source_tweet_id,json=sourceTweetId,proto3" json:"source_tweet_id,omitempty"`
-	Thumb         *Media_Size `protobuf:"bytes,7,opt,name=thumb,proto3" json:"thumb,omitempty"`
-	Small         *Media_Size `protobuf:"bytes,8,opt,name=small,proto3" json:"small,omitempty"`
-	Medium        *Media_Size `protobuf:"bytes,9,opt,name=medium,proto3" json:"medium,omitempty"`
-	Large         *Media_Size `protobuf:"bytes,10,opt,name=large,proto3" json:"large,omitempty"`
+	Auth    *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	MediaId uint64          `protobuf:"fixed64,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
 }
 
-func (x *Media) Reset() {
-	*x = Media{}
+func (x *MediaStatusRequest) Reset() {
+	*x = MediaStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[26]
+		mi := &file_twitter1_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Media) String() string {
+func (x *MediaStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Media) ProtoMessage() {}
+func (*MediaStatusRequest) ProtoMessage() {}
 
-func (x *Media) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[26]
+func (x *MediaStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2408,108 +2713,50 @@ func (x *Media) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Media.ProtoReflect.Descriptor instead.
-func (*Media) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MediaStatusRequest.ProtoReflect.Descriptor instead.
+func (*MediaStatusRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Media) GetUrl() *URL {
+func (x *MediaStatusRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.Url
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *Media) GetId() uint64 {
+func (x *MediaStatusRequest) GetMediaId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.MediaId
 	}
 	return 0
 }
 
-func (x *Media) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *Media) GetMediaUrl() string {
-	if x != nil {
-		return x.MediaUrl
-	}
-	return ""
-}
-
-func (x *Media) GetAlt() string {
-	if x != nil {
-		return x.Alt
-	}
-	return ""
-}
-
-func (x *Media) GetSourceTweetId() *OptFixed64 {
-	if x != nil {
-		return x.SourceTweetId
-	}
-	return nil
-}
-
-func (x *Media) GetThumb() *Media_Size {
-	if x != nil {
-		return x.Thumb
-	}
-	return nil
-}
-
-func (x *Media) GetSmall() *Media_Size {
-	if x != nil {
-		return x.Small
-	}
-	return nil
-}
-
-func (x *Media) GetMedium() *Media_Size {
-	if x != nil {
-		return x.Medium
-	}
-	return nil
-}
-
-func (x *Media) GetLarge() *Media_Size {
-	if x != nil {
-		return x.Large
-	}
-	return nil
-}
-
-type Poll struct {
+type RateLimitsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EndTime         int64          `protobuf:"varint,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	DurationMinutes uint32         `protobuf:"varint,2,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
-	Options         []*Poll_Option `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Auth *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
 }
 
-func (x *Poll) Reset() {
-	*x = Poll{}
+func (x *RateLimitsRequest) Reset() {
+	*x = RateLimitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[27]
+		mi := &file_twitter1_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Poll) String() string {
+func (x *RateLimitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Poll) ProtoMessage() {}
+func (*RateLimitsRequest) ProtoMessage() {}
 
-func (x *Poll) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[27]
+func (x *RateLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,63 +2767,46 @@ func (x *Poll) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
-func (*Poll) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *Poll) GetEndTime() int64 {
-	if x != nil {
-		return x.EndTime
-	}
-	return 0
-}
-
-func (x *Poll) GetDurationMinutes() uint32 {
-	if x != nil {
-		return x.DurationMinutes
-	}
-	return 0
+// Deprecated: Use RateLimitsRequest.ProtoReflect.Descriptor instead.
+func (*RateLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Poll) GetOptions() []*Poll_Option {
+func (x *RateLimitsRequest) GetAuth() *Authentication {
 	if x != nil {
-		return x.Options
+		return x.Auth
 	}
 	return nil
 }
 
-type RawAPIRequest struct {
+type TweetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth        *Authentication   `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
-	Method      string            `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	Protocol    string            `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Version     string            `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Path        string            `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
-	QueryParams map[string]string `protobuf:"bytes,6,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	BodyParams  map[string]string `protobuf:"bytes,7,rep,name=body_params,json=bodyParams,proto3" json:"body_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Response:
+	//	*TweetResponse_Tweet
+	//	*TweetResponse_Error
+	Response isTweetResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *RawAPIRequest) Reset() {
-	*x = RawAPIRequest{}
+func (x *TweetResponse) Reset() {
+	*x = TweetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[28]
+		mi := &file_twitter1_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RawAPIRequest) String() string {
+func (x *TweetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RawAPIRequest) ProtoMessage() {}
+func (*TweetResponse) ProtoMessage() {}
 
-func (x *RawAPIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[28]
+func (x *TweetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2587,87 +2817,76 @@ func (x *RawAPIRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RawAPIRequest.ProtoReflect.Descriptor instead.
-func (*RawAPIRequest) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use TweetResponse.ProtoReflect.Descriptor instead.
+func (*TweetResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RawAPIRequest) GetAuth() *Authentication {
-	if x != nil {
-		return x.Auth
+func (m *TweetResponse) GetResponse() isTweetResponse_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-func (x *RawAPIRequest) GetMethod() string {
-	if x != nil {
-		return x.Method
+func (x *TweetResponse) GetTweet() *Tweet {
+	if x, ok := x.GetResponse().(*TweetResponse_Tweet); ok {
+		return x.Tweet
 	}
-	return ""
+	return nil
 }
 
-func (x *RawAPIRequest) GetProtocol() string {
-	if x != nil {
-		return x.Protocol
+func (x *TweetResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*TweetResponse_Error); ok {
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-func (x *RawAPIRequest) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+type isTweetResponse_Response interface {
+	isTweetResponse_Response()
 }
 
-func (x *RawAPIRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+type TweetResponse_Tweet struct {
+	Tweet *Tweet `protobuf:"bytes,1,opt,name=tweet,proto3,oneof"`
 }
 
-func (x *RawAPIRequest) GetQueryParams() map[string]string {
-	if x != nil {
-		return x.QueryParams
-	}
-	return nil
+type TweetResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (x *RawAPIRequest) GetBodyParams() map[string]string {
-	if x != nil {
-		return x.BodyParams
-	}
-	return nil
-}
+func (*TweetResponse_Tweet) isTweetResponse_Response() {}
 
-type RawAPIResult struct {
+func (*TweetResponse_Error) isTweetResponse_Response() {}
+
+type TweetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Status  uint32            `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Body    []byte            `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Types that are assignable to Response:
+	//	*TweetsResponse_Tweets
+	//	*TweetsResponse_Error
+	Response isTweetsResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *RawAPIResult) Reset() {
-	*x = RawAPIResult{}
+func (x *TweetsResponse) Reset() {
+	*x = TweetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[29]
+		mi := &file_twitter1_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RawAPIResult) String() string {
+func (x *TweetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RawAPIResult) ProtoMessage() {}
+func (*TweetsResponse) ProtoMessage() {}
 
-func (x *RawAPIResult) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[29]
+func (x *TweetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2678,59 +2897,76 @@ func (x *RawAPIResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RawAPIResult.ProtoReflect.Descriptor instead.
-func (*RawAPIResult) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use TweetsResponse.ProtoReflect.Descriptor instead.
+func (*TweetsResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *RawAPIResult) GetHeaders() map[string]string {
-	if x != nil {
-		return x.Headers
+func (m *TweetsResponse) GetResponse() isTweetsResponse_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-func (x *RawAPIResult) GetStatus() uint32 {
-	if x != nil {
-		return x.Status
+func (x *TweetsResponse) GetTweets() *Tweets {
+	if x, ok := x.GetResponse().(*TweetsResponse_Tweets); ok {
+		return x.Tweets
 	}
-	return 0
+	return nil
 }
 
-func (x *RawAPIResult) GetBody() []byte {
-	if x != nil {
-		return x.Body
+func (x *TweetsResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*TweetsResponse_Error); ok {
+		return x.Error
 	}
 	return nil
 }
 
-type Tweet_ReplyData struct {
+type isTweetsResponse_Response interface {
+	isTweetsResponse_Response()
+}
+
+type TweetsResponse_Tweets struct {
+	Tweets *Tweets `protobuf:"bytes,1,opt,name=tweets,proto3,oneof"`
+}
+
+type TweetsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*TweetsResponse_Tweets) isTweetsResponse_Response() {}
+
+func (*TweetsResponse_Error) isTweetsResponse_Response() {}
+
+type UserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplyToTweetId    uint64 `protobuf:"fixed64,1,opt,name=reply_to_tweet_id,json=replyToTweetId,proto3" json:"reply_to_tweet_id,omitempty"`
-	ReplyToUserId     uint64 `protobuf:"fixed64,2,opt,name=reply_to_user_id,json=replyToUserId,proto3" json:"reply_to_user_id,omitempty"`
-	ReplyToUserHandle string `protobuf:"bytes,3,opt,name=reply_to_user_handle,json=replyToUserHandle,proto3" json:"reply_to_user_handle,omitempty"`
+	// Types that are assignable to Response:
+	//	*UserResponse_User
+	//	*UserResponse_Error
+	Response isUserResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *Tweet_ReplyData) Reset() {
-	*x = Tweet_ReplyData{}
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[30]
+		mi := &file_twitter1_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Tweet_ReplyData) String() string {
+func (x *UserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tweet_ReplyData) ProtoMessage() {}
+func (*UserResponse) ProtoMessage() {}
 
-func (x *Tweet_ReplyData) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[30]
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2741,59 +2977,76 @@ func (x *Tweet_ReplyData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tweet_ReplyData.ProtoReflect.Descriptor instead.
-func (*Tweet_ReplyData) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Tweet_ReplyData) GetReplyToTweetId() uint64 {
-	if x != nil {
-		return x.ReplyToTweetId
+func (m *UserResponse) GetResponse() isUserResponse_Response {
+	if m != nil {
+		return m.Response
 	}
-	return 0
+	return nil
 }
 
-func (x *Tweet_ReplyData) GetReplyToUserId() uint64 {
-	if x != nil {
-		return x.ReplyToUserId
+func (x *UserResponse) GetUser() *User {
+	if x, ok := x.GetResponse().(*UserResponse_User); ok {
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *Tweet_ReplyData) GetReplyToUserHandle() string {
-	if x != nil {
-		return x.ReplyToUserHandle
+func (x *UserResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*UserResponse_Error); ok {
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-type Media_Size struct {
+type isUserResponse_Response interface {
+	isUserResponse_Response()
+}
+
+type UserResponse_User struct {
+	User *User `protobuf:"bytes,1,opt,name=user,proto3,oneof"`
+}
+
+type UserResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UserResponse_User) isUserResponse_Response() {}
+
+func (*UserResponse_Error) isUserResponse_Response() {}
+
+type SearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Width  uint32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
-	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Resize string `protobuf:"bytes,3,opt,name=resize,proto3" json:"resize,omitempty"`
+	// Types that are assignable to Response:
+	//	*SearchResponse_Result
+	//	*SearchResponse_Error
+	Response isSearchResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *Media_Size) Reset() {
-	*x = Media_Size{}
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[31]
+		mi := &file_twitter1_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Media_Size) String() string {
+func (x *SearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Media_Size) ProtoMessage() {}
+func (*SearchResponse) ProtoMessage() {}
 
-func (x *Media_Size) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[31]
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2804,58 +3057,76 @@ func (x *Media_Size) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Media_Size.ProtoReflect.Descriptor instead.
-func (*Media_Size) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{26, 0}
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Media_Size) GetWidth() uint32 {
-	if x != nil {
-		return x.Width
+func (m *SearchResponse) GetResponse() isSearchResponse_Response {
+	if m != nil {
+		return m.Response
 	}
-	return 0
+	return nil
 }
 
-func (x *Media_Size) GetHeight() uint32 {
-	if x != nil {
-		return x.Height
+func (x *SearchResponse) GetResult() *SearchResult {
+	if x, ok := x.GetResponse().(*SearchResponse_Result); ok {
+		return x.Result
 	}
-	return 0
+	return nil
 }
 
-func (x *Media_Size) GetResize() string {
-	if x != nil {
-		return x.Resize
+func (x *SearchResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*SearchResponse_Error); ok {
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-type Poll_Option struct {
+type isSearchResponse_Response interface {
+	isSearchResponse_Response()
+}
+
+type SearchResponse_Result struct {
+	Result *SearchResult `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+}
+
+type SearchResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SearchResponse_Result) isSearchResponse_Response() {}
+
+func (*SearchResponse_Error) isSearchResponse_Response() {}
+
+type UsersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position uint32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
-	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Types that are assignable to Response:
+	//	*UsersResponse_Users
+	//	*UsersResponse_Error
+	Response isUsersResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *Poll_Option) Reset() {
-	*x = Poll_Option{}
+func (x *UsersResponse) Reset() {
+	*x = UsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_twitter1_proto_msgTypes[32]
+		mi := &file_twitter1_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Poll_Option) String() string {
+func (x *UsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Poll_Option) ProtoMessage() {}
+func (*UsersResponse) ProtoMessage() {}
 
-func (x *Poll_Option) ProtoReflect() protoreflect.Message {
-	mi := &file_twitter1_proto_msgTypes[32]
+func (x *UsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2866,1612 +3137,7258 @@ func (x *Poll_Option) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Poll_Option.ProtoReflect.Descriptor instead.
-func (*Poll_Option) Descriptor() ([]byte, []int) {
-	return file_twitter1_proto_rawDescGZIP(), []int{27, 0}
+// Deprecated: Use UsersResponse.ProtoReflect.Descriptor instead.
+func (*UsersResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Poll_Option) GetPosition() uint32 {
-	if x != nil {
-		return x.Position
+func (m *UsersResponse) GetResponse() isUsersResponse_Response {
+	if m != nil {
+		return m.Response
 	}
-	return 0
+	return nil
 }
 
-func (x *Poll_Option) GetText() string {
-	if x != nil {
-		return x.Text
+func (x *UsersResponse) GetUsers() *Users {
+	if x, ok := x.GetResponse().(*UsersResponse_Users); ok {
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-var File_twitter1_proto protoreflect.FileDescriptor
+func (x *UsersResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*UsersResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
 
-var file_twitter1_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x22, 0x1c, 0x0a, 0x08, 0x4f, 0x70,
-	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x09, 0x4f, 0x70, 0x74, 0x55,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x1e, 0x0a, 0x0a, 0x4f, 0x70, 0x74, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x06, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x09, 0x4f, 0x70, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x99, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x28, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
-	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x0a,
-	0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
-	0x54, 0x57, 0x49, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02,
-	0x12, 0x10, 0x0a, 0x0c, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
-	0x10, 0x03, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a,
-	0x07, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x6e, 0x64,
-	0x22, 0xb2, 0x02, 0x0a, 0x0c, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x72, 0x69, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2c,
-	0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x79, 0x5f, 0x72, 0x65, 0x74,
-	0x77, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x4d, 0x79, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78,
-	0x74, 0x41, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x72, 0x64, 0x55,
-	0x72, 0x69, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1b, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x22, 0x20, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43,
-	0x4f, 0x4d, 0x50, 0x41, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x54, 0x45, 0x4e,
-	0x44, 0x45, 0x44, 0x10, 0x01, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2b, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06,
-	0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
-	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x52, 0x05, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x77, 0x6f,
-	0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x0c, 0x54, 0x77, 0x65,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x7f, 0x0a, 0x0d, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x06, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x77, 0x6f, 0x70,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x22, 0xd2, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2d,
-	0x0a, 0x07, 0x67, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a,
-	0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0f, 0x75, 0x6e, 0x74, 0x69,
-	0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x0e, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x30, 0x0a, 0x0a, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x58,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x50, 0x55, 0x4c, 0x41, 0x52, 0x10, 0x02, 0x22, 0xb2, 0x01,
-	0x0a, 0x13, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x10, 0x74,
-	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x48,
-	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x42,
-	0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x8e, 0x04, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f,
-	0x70, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79,
-	0x49, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x70, 0x6f, 0x70, 0x75, 0x6c,
-	0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x50, 0x6f,
-	0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72,
-	0x65, 0x70, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x06, 0x52, 0x13, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x06, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64,
-	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x5f, 0x73, 0x65,
-	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70,
-	0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x6d, 0x5f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x44, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x28,
-	0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x44, 0x6d,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x77, 0x6f, 0x70,
-	0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
-	0x27, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
-	0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
-	0x2f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x25, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x32, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
-	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
-	0x73, 0x22, 0x6d, 0x0a, 0x0d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65,
-	0x65, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x77, 0x65, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x71, 0x0a, 0x0e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77,
-	0x65, 0x65, 0x74, 0x73, 0x48, 0x00, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x27,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
-	0x0a, 0x06, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x22, 0xa1, 0x0a, 0x0a, 0x05, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3f,
-	0x0a, 0x12, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x52, 0x10, 0x74,
-	0x65, 0x78, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0c, 0x71, 0x75, 0x6f,
-	0x74, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x38, 0x0a,
-	0x0f, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74,
-	0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x71, 0x75,
-	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c,
-	0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72,
-	0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74,
-	0x77, 0x65, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
-	0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x65, 0x64,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x65,
-	0x64, 0x12, 0x4b, 0x0a, 0x17, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2c,
-	0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x04,
-	0x75, 0x72, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x77, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12,
-	0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x6e,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a,
-	0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
-	0x52, 0x05, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x73, 0x69,
-	0x62, 0x6c, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x53, 0x65, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e,
-	0x67, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x2d, 0x0a,
-	0x12, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x68,
-	0x65, 0x6c, 0x64, 0x43, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x12,
-	0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65,
-	0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77,
-	0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x1d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x6f,
-	0x70, 0x65, 0x1a, 0x90, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x29, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x77, 0x65,
-	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0e, 0x72, 0x65, 0x70,
-	0x6c, 0x79, 0x54, 0x6f, 0x54, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x72,
-	0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x48,
-	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x80, 0x06, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2d,
-	0x0a, 0x12, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68,
-	0x68, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a,
-	0x0e, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x53,
-	0x63, 0x6f, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x75, 0x72, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x73,
-	0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x31, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x07, 0x75, 0x72, 0x6c, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x28,
-	0x0a, 0x08, 0x62, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x52, 0x4c, 0x52,
-	0x07, 0x62, 0x69, 0x6f, 0x55, 0x72, 0x6c, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x03, 0x55, 0x52, 0x4c,
-	0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x49, 0x6e, 0x64,
-	0x69, 0x63, 0x65, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1f,
-	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x72, 0x6c, 0x12,
-	0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x55,
-	0x72, 0x6c, 0x22, 0x49, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2b, 0x0a, 0x07,
-	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73,
-	0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x8a, 0x01,
-	0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x64,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x52, 0x07, 0x69,
-	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb9, 0x03, 0x0a, 0x05, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x12, 0x1f, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x52, 0x4c,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64,
-	0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
-	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x05, 0x74, 0x68, 0x75,
-	0x6d, 0x62, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x05, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x12, 0x2c,
-	0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x2e,
-	0x53, 0x69, 0x7a, 0x65, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x05,
-	0x6c, 0x61, 0x72, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x53, 0x69, 0x7a,
-	0x65, 0x52, 0x05, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x1a, 0x4c, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12,
-	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
-	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x38, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x22, 0xb5, 0x03, 0x0a, 0x0d, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x4b, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x48, 0x0a, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
-	0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x6f,
-	0x64, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x62,
-	0x6f, 0x64, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x42, 0x6f, 0x64,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb5, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x77,
-	0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x77, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x32, 0xc1, 0x07, 0x0a, 0x07, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77,
-	0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09,
-	0x4c, 0x69, 0x6b, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x55, 0x6e,
-	0x6c, 0x69, 0x6b, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x52, 0x65,
-	0x74, 0x77, 0x65, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77,
-	0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x55,
-	0x6e, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e,
-	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
-	0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
-	0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e,
-	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
-	0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x1d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x48, 0x6f, 0x6d,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x20, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77,
-	0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x1d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x54,
-	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x47, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x1e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x77, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52,
-	0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74,
-	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x6e, 0x74, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x72, 0x65, 0x2f, 0x67,
-	0x6f, 0x6c, 0x64, 0x63, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type isUsersResponse_Response interface {
+	isUsersResponse_Response()
 }
 
-var (
-	file_twitter1_proto_rawDescOnce sync.Once
-	file_twitter1_proto_rawDescData = file_twitter1_proto_rawDesc
-)
+type UsersResponse_Users struct {
+	Users *Users `protobuf:"bytes,1,opt,name=users,proto3,oneof"`
+}
 
-func file_twitter1_proto_rawDescGZIP() []byte {
-	file_twitter1_proto_rawDescOnce.Do(func() {
-		file_twitter1_proto_rawDescData = protoimpl.X.CompressGZIP(file_twitter1_proto_rawDescData)
-	})
-	return file_twitter1_proto_rawDescData
+type UsersResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-var file_twitter1_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_twitter1_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
-var file_twitter1_proto_goTypes = []interface{}{
-	(Error_Code)(0),                // 0: twitter1.Error.Code
-	(TweetOptions_Mode)(0),         // 1: twitter1.TweetOptions.Mode
-	(SearchRequest_ResultType)(0),  // 2: twitter1.SearchRequest.ResultType
-	(*OptInt64)(nil),               // 3: twitter1.OptInt64
-	(*OptUint64)(nil),              // 4: twitter1.OptUint64
-	(*OptFixed64)(nil),             // 5: twitter1.OptFixed64
-	(*OptString)(nil),              // 6: twitter1.OptString
-	(*Error)(nil),                  // 7: twitter1.Error
-	(*Authentication)(nil),         // 8: twitter1.Authentication
-	(*Indices)(nil),                // 9: twitter1.Indices
-	(*TweetOptions)(nil),           // 10: twitter1.TweetOptions
-	(*TimelineOptions)(nil),        // 11: twitter1.TimelineOptions
-	(*TweetRequest)(nil),           // 12: twitter1.TweetRequest
-	(*TweetsRequest)(nil),          // 13: twitter1.TweetsRequest
-	(*SearchRequest)(nil),          // 14: twitter1.SearchRequest
-	(*HomeTimelineRequest)(nil),    // 15: twitter1.HomeTimelineRequest
-	(*MentionTimelineRequest)(nil), // 16: twitter1.MentionTimelineRequest
-	(*UserTimelineRequest)(nil),    // 17: twitter1.UserTimelineRequest
-	(*PublishTweetRequest)(nil),    // 18: twitter1.PublishTweetRequest
-	(*UpdateProfileRequest)(nil),   // 19: twitter1.UpdateProfileRequest
-	(*TweetResponse)(nil),          // 20: twitter1.TweetResponse
-	(*TweetsResponse)(nil),         // 21: twitter1.TweetsResponse
-	(*UserResponse)(nil),           // 22: twitter1.UserResponse
-	(*Tweets)(nil),                 // 23: twitter1.Tweets
-	(*Tweet)(nil),                  // 24: twitter1.Tweet
-	(*User)(nil),                   // 25: twitter1.User
-	(*URL)(nil),                    // 26: twitter1.URL
-	(*Symbol)(nil),                 // 27: twitter1.Symbol
-	(*Mention)(nil),                // 28: twitter1.Mention
-	(*Media)(nil),                  // 29: twitter1.Media
-	(*Poll)(nil),                   // 30: twitter1.Poll
-	(*RawAPIRequest)(nil),          // 31: twitter1.RawAPIRequest
-	(*RawAPIResult)(nil),           // 32: twitter1.RawAPIResult
-	(*Tweet_ReplyData)(nil),        // 33: twitter1.Tweet.ReplyData
-	(*Media_Size)(nil),             // 34: twitter1.Media.Size
-	(*Poll_Option)(nil),            // 35: twitter1.Poll.Option
-	nil,                            // 36: twitter1.RawAPIRequest.QueryParamsEntry
-	nil,                            // 37: twitter1.RawAPIRequest.BodyParamsEntry
-	nil,                            // 38: twitter1.RawAPIResult.HeadersEntry
+func (*UsersResponse_Users) isUsersResponse_Response() {}
+
+func (*UsersResponse_Error) isUsersResponse_Response() {}
+
+type UserIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*UserIdsResponse_Page
+	//	*UserIdsResponse_Error
+	Response isUserIdsResponse_Response `protobuf_oneof:"response"`
 }
-var file_twitter1_proto_depIdxs = []int32{
-	0,  // 0: twitter1.Error.code:type_name -> twitter1.Error.Code
-	1,  // 1: twitter1.TweetOptions.mode:type_name -> twitter1.TweetOptions.Mode
-	5,  // 2: twitter1.TimelineOptions.min_id:type_name -> twitter1.OptFixed64
-	5,  // 3: twitter1.TimelineOptions.max_id:type_name -> twitter1.OptFixed64
-	10, // 4: twitter1.TimelineOptions.twopts:type_name -> twitter1.TweetOptions
-	8,  // 5: twitter1.TweetRequest.auth:type_name -> twitter1.Authentication
-	10, // 6: twitter1.TweetRequest.twopts:type_name -> twitter1.TweetOptions
-	8,  // 7: twitter1.TweetsRequest.auth:type_name -> twitter1.Authentication
-	10, // 8: twitter1.TweetsRequest.twopts:type_name -> twitter1.TweetOptions
-	8,  // 9: twitter1.SearchRequest.auth:type_name -> twitter1.Authentication
-	6,  // 10: twitter1.SearchRequest.geocode:type_name -> twitter1.OptString
-	6,  // 11: twitter1.SearchRequest.lang:type_name -> twitter1.OptString
-	6,  // 12: twitter1.SearchRequest.locale:type_name -> twitter1.OptString
-	2,  // 13: twitter1.SearchRequest.result_type:type_name -> twitter1.SearchRequest.ResultType
-	3,  // 14: twitter1.SearchRequest.until_timestamp:type_name -> twitter1.OptInt64
-	11, // 15: twitter1.SearchRequest.timeline_options:type_name -> twitter1.TimelineOptions
-	8,  // 16: twitter1.HomeTimelineRequest.auth:type_name -> twitter1.Authentication
-	11, // 17: twitter1.HomeTimelineRequest.timeline_options:type_name -> twitter1.TimelineOptions
-	8,  // 18: twitter1.MentionTimelineRequest.auth:type_name -> twitter1.Authentication
-	11, // 19: twitter1.MentionTimelineRequest.timeline_options:type_name -> twitter1.TimelineOptions
-	8,  // 20: twitter1.UserTimelineRequest.auth:type_name -> twitter1.Authentication
-	11, // 21: twitter1.UserTimelineRequest.timeline_options:type_name -> twitter1.TimelineOptions
-	8,  // 22: twitter1.PublishTweetRequest.auth:type_name -> twitter1.Authentication
-	5,  // 23: twitter1.PublishTweetRequest.reply_id:type_name -> twitter1.OptFixed64
-	6,  // 24: twitter1.PublishTweetRequest.attachment_url:type_name -> twitter1.OptString
-	10, // 25: twitter1.PublishTweetRequest.twopts:type_name -> twitter1.TweetOptions
-	8,  // 26: twitter1.UpdateProfileRequest.auth:type_name -> twitter1.Authentication
-	6,  // 27: twitter1.UpdateProfileRequest.name:type_name -> twitter1.OptString
-	6,  // 28: twitter1.UpdateProfileRequest.url:type_name -> twitter1.OptString
-	6,  // 29: twitter1.UpdateProfileRequest.location:type_name -> twitter1.OptString
-	6,  // 30: twitter1.UpdateProfileRequest.bio:type_name -> twitter1.OptString
-	6,  // 31: twitter1.UpdateProfileRequest.link_color:type_name -> twitter1.OptString
-	24, // 32: twitter1.TweetResponse.tweet:type_name -> twitter1.Tweet
-	7,  // 33: twitter1.TweetResponse.error:type_name -> twitter1.Error
-	23, // 34: twitter1.TweetsResponse.tweets:type_name -> twitter1.Tweets
-	7,  // 35: twitter1.TweetsResponse.error:type_name -> twitter1.Error
-	25, // 36: twitter1.UserResponse.user:type_name -> twitter1.User
-	7,  // 37: twitter1.UserResponse.error:type_name -> twitter1.Error
-	24, // 38: twitter1.Tweets.tweets:type_name -> twitter1.Tweet
-	9,  // 39: twitter1.Tweet.text_display_range:type_name -> twitter1.Indices
-	25, // 40: twitter1.Tweet.user:type_name -> twitter1.User
-	33, // 41: twitter1.Tweet.replied_tweet:type_name -> twitter1.Tweet.ReplyData
-	24, // 42: twitter1.Tweet.quoted_tweet:type_name -> twitter1.Tweet
-	24, // 43: twitter1.Tweet.retweeted_tweet:type_name -> twitter1.Tweet
-	5,  // 44: twitter1.Tweet.current_user_retweet_id:type_name -> twitter1.OptFixed64
-	27, // 45: twitter1.Tweet.hashtags:type_name -> twitter1.Symbol
-	26, // 46: twitter1.Tweet.urls:type_name -> twitter1.URL
-	28, // 47: twitter1.Tweet.mentions:type_name -> twitter1.Mention
-	27, // 48: twitter1.Tweet.symbols:type_name -> twitter1.Symbol
-	29, // 49: twitter1.Tweet.media:type_name -> twitter1.Media
-	30, // 50: twitter1.Tweet.polls:type_name -> twitter1.Poll
-	26, // 51: twitter1.User.url_urls:type_name -> twitter1.URL
-	26, // 52: twitter1.User.bio_urls:type_name -> twitter1.URL
-	9,  // 53: twitter1.URL.indices:type_name -> twitter1.Indices
-	9,  // 54: twitter1.Symbol.indices:type_name -> twitter1.Indices
-	9,  // 55: twitter1.Mention.indices:type_name -> twitter1.Indices
-	26, // 56: twitter1.Media.url:type_name -> twitter1.URL
-	5,  // 57: twitter1.Media.source_tweet_id:type_name -> twitter1.OptFixed64
-	34, // 58: twitter1.Media.thumb:type_name -> twitter1.Media.Size
-	34, // 59: twitter1.Media.small:type_name -> twitter1.Media.Size
-	34, // 60: twitter1.Media.medium:type_name -> twitter1.Media.Size
-	34, // 61: twitter1.Media.large:type_name -> twitter1.Media.Size
-	35, // 62: twitter1.Poll.options:type_name -> twitter1.Poll.Option
-	8,  // 63: twitter1.RawAPIRequest.auth:type_name -> twitter1.Authentication
-	36, // 64: twitter1.RawAPIRequest.query_params:type_name -> twitter1.RawAPIRequest.QueryParamsEntry
-	37, // 65: twitter1.RawAPIRequest.body_params:type_name -> twitter1.RawAPIRequest.BodyParamsEntry
-	38, // 66: twitter1.RawAPIResult.headers:type_name -> twitter1.RawAPIResult.HeadersEntry
-	12, // 67: twitter1.Twitter.GetTweet:input_type -> twitter1.TweetRequest
-	13, // 68: twitter1.Twitter.GetTweets:input_type -> twitter1.TweetsRequest
-	14, // 69: twitter1.Twitter.SearchTweets:input_type -> twitter1.SearchRequest
-	12, // 70: twitter1.Twitter.LikeTweet:input_type -> twitter1.TweetRequest
-	12, // 71: twitter1.Twitter.UnlikeTweet:input_type -> twitter1.TweetRequest
-	12, // 72: twitter1.Twitter.RetweetTweet:input_type -> twitter1.TweetRequest
-	12, // 73: twitter1.Twitter.UnretweetTweet:input_type -> twitter1.TweetRequest
-	12, // 74: twitter1.Twitter.DeleteTweet:input_type -> twitter1.TweetRequest
-	15, // 75: twitter1.Twitter.GetHomeTimeline:input_type -> twitter1.HomeTimelineRequest
-	16, // 76: twitter1.Twitter.GetMentionTimeline:input_type -> twitter1.MentionTimelineRequest
-	17, // 77: twitter1.Twitter.GetUserTimeline:input_type -> twitter1.UserTimelineRequest
-	18, // 78: twitter1.Twitter.PublishTweet:input_type -> twitter1.PublishTweetRequest
-	19, // 79: twitter1.Twitter.UpdateProfile:input_type -> twitter1.UpdateProfileRequest
-	31, // 80: twitter1.Twitter.GetRaw:input_type -> twitter1.RawAPIRequest
-	20, // 81: twitter1.Twitter.GetTweet:output_type -> twitter1.TweetResponse
-	21, // 82: twitter1.Twitter.GetTweets:output_type -> twitter1.TweetsResponse
-	21, // 83: twitter1.Twitter.SearchTweets:output_type -> twitter1.TweetsResponse
-	20, // 84: twitter1.Twitter.LikeTweet:output_type -> twitter1.TweetResponse
-	20, // 85: twitter1.Twitter.UnlikeTweet:output_type -> twitter1.TweetResponse
-	20, // 86: twitter1.Twitter.RetweetTweet:output_type -> twitter1.TweetResponse
-	20, // 87: twitter1.Twitter.UnretweetTweet:output_type -> twitter1.TweetResponse
-	20, // 88: twitter1.Twitter.DeleteTweet:output_type -> twitter1.TweetResponse
-	21, // 89: twitter1.Twitter.GetHomeTimeline:output_type -> twitter1.TweetsResponse
-	21, // 90: twitter1.Twitter.GetMentionTimeline:output_type -> twitter1.TweetsResponse
-	21, // 91: twitter1.Twitter.GetUserTimeline:output_type -> twitter1.TweetsResponse
-	20, // 92: twitter1.Twitter.PublishTweet:output_type -> twitter1.TweetResponse
-	22, // 93: twitter1.Twitter.UpdateProfile:output_type -> twitter1.UserResponse
-	32, // 94: twitter1.Twitter.GetRaw:output_type -> twitter1.RawAPIResult
-	81, // [81:95] is the sub-list for method output_type
-	67, // [67:81] is the sub-list for method input_type
-	67, // [67:67] is the sub-list for extension type_name
-	67, // [67:67] is the sub-list for extension extendee
-	0,  // [0:67] is the sub-list for field type_name
+
+func (x *UserIdsResponse) Reset() {
+	*x = UserIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_twitter1_proto_init() }
-func file_twitter1_proto_init() {
-	if File_twitter1_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_twitter1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptInt64); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptUint64); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptFixed64); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptString); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (x *UserIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIdsResponse) ProtoMessage() {}
+
+func (x *UserIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Error); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIdsResponse.ProtoReflect.Descriptor instead.
+func (*UserIdsResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{39}
+}
+
+func (m *UserIdsResponse) GetResponse() isUserIdsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UserIdsResponse) GetPage() *UserIdPage {
+	if x, ok := x.GetResponse().(*UserIdsResponse_Page); ok {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *UserIdsResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*UserIdsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUserIdsResponse_Response interface {
+	isUserIdsResponse_Response()
+}
+
+type UserIdsResponse_Page struct {
+	Page *UserIdPage `protobuf:"bytes,1,opt,name=page,proto3,oneof"`
+}
+
+type UserIdsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UserIdsResponse_Page) isUserIdsResponse_Response() {}
+
+func (*UserIdsResponse_Error) isUserIdsResponse_Response() {}
+
+type UserPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*UserPageResponse_Page
+	//	*UserPageResponse_Error
+	Response isUserPageResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *UserPageResponse) Reset() {
+	*x = UserPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPageResponse) ProtoMessage() {}
+
+func (x *UserPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Authentication); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPageResponse.ProtoReflect.Descriptor instead.
+func (*UserPageResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{40}
+}
+
+func (m *UserPageResponse) GetResponse() isUserPageResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UserPageResponse) GetPage() *UserPage {
+	if x, ok := x.GetResponse().(*UserPageResponse_Page); ok {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *UserPageResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*UserPageResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUserPageResponse_Response interface {
+	isUserPageResponse_Response()
+}
+
+type UserPageResponse_Page struct {
+	Page *UserPage `protobuf:"bytes,1,opt,name=page,proto3,oneof"`
+}
+
+type UserPageResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UserPageResponse_Page) isUserPageResponse_Response() {}
+
+func (*UserPageResponse_Error) isUserPageResponse_Response() {}
+
+type RelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*RelationshipResponse_Relationship
+	//	*RelationshipResponse_Error
+	Response isRelationshipResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RelationshipResponse) Reset() {
+	*x = RelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipResponse) ProtoMessage() {}
+
+func (x *RelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Indices); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipResponse.ProtoReflect.Descriptor instead.
+func (*RelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{41}
+}
+
+func (m *RelationshipResponse) GetResponse() isRelationshipResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RelationshipResponse) GetRelationship() *Relationship {
+	if x, ok := x.GetResponse().(*RelationshipResponse_Relationship); ok {
+		return x.Relationship
+	}
+	return nil
+}
+
+func (x *RelationshipResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*RelationshipResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRelationshipResponse_Response interface {
+	isRelationshipResponse_Response()
+}
+
+type RelationshipResponse_Relationship struct {
+	Relationship *Relationship `protobuf:"bytes,1,opt,name=relationship,proto3,oneof"`
+}
+
+type RelationshipResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RelationshipResponse_Relationship) isRelationshipResponse_Response() {}
+
+func (*RelationshipResponse_Error) isRelationshipResponse_Response() {}
+
+type DirectMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*DirectMessageResponse_Message
+	//	*DirectMessageResponse_Error
+	Response isDirectMessageResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *DirectMessageResponse) Reset() {
+	*x = DirectMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectMessageResponse) ProtoMessage() {}
+
+func (x *DirectMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TweetOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectMessageResponse.ProtoReflect.Descriptor instead.
+func (*DirectMessageResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{42}
+}
+
+func (m *DirectMessageResponse) GetResponse() isDirectMessageResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *DirectMessageResponse) GetMessage() *DirectMessage {
+	if x, ok := x.GetResponse().(*DirectMessageResponse_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *DirectMessageResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*DirectMessageResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isDirectMessageResponse_Response interface {
+	isDirectMessageResponse_Response()
+}
+
+type DirectMessageResponse_Message struct {
+	Message *DirectMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type DirectMessageResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*DirectMessageResponse_Message) isDirectMessageResponse_Response() {}
+
+func (*DirectMessageResponse_Error) isDirectMessageResponse_Response() {}
+
+type DirectMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*DirectMessagesResponse_Page
+	//	*DirectMessagesResponse_Error
+	Response isDirectMessagesResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *DirectMessagesResponse) Reset() {
+	*x = DirectMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectMessagesResponse) ProtoMessage() {}
+
+func (x *DirectMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimelineOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectMessagesResponse.ProtoReflect.Descriptor instead.
+func (*DirectMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{43}
+}
+
+func (m *DirectMessagesResponse) GetResponse() isDirectMessagesResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *DirectMessagesResponse) GetPage() *DirectMessagePage {
+	if x, ok := x.GetResponse().(*DirectMessagesResponse_Page); ok {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *DirectMessagesResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*DirectMessagesResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isDirectMessagesResponse_Response interface {
+	isDirectMessagesResponse_Response()
+}
+
+type DirectMessagesResponse_Page struct {
+	Page *DirectMessagePage `protobuf:"bytes,1,opt,name=page,proto3,oneof"`
+}
+
+type DirectMessagesResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*DirectMessagesResponse_Page) isDirectMessagesResponse_Response() {}
+
+func (*DirectMessagesResponse_Error) isDirectMessagesResponse_Response() {}
+
+type ListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*ListResponse_List
+	//	*ListResponse_Error
+	Response isListResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TweetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{44}
+}
+
+func (m *ListResponse) GetResponse() isListResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListResponse) GetList() *List {
+	if x, ok := x.GetResponse().(*ListResponse_List); ok {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ListResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListResponse_Response interface {
+	isListResponse_Response()
+}
+
+type ListResponse_List struct {
+	List *List `protobuf:"bytes,1,opt,name=list,proto3,oneof"`
+}
+
+type ListResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListResponse_List) isListResponse_Response() {}
+
+func (*ListResponse_Error) isListResponse_Response() {}
+
+type ListPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*ListPageResponse_Page
+	//	*ListPageResponse_Error
+	Response isListPageResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ListPageResponse) Reset() {
+	*x = ListPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPageResponse) ProtoMessage() {}
+
+func (x *ListPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TweetsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPageResponse.ProtoReflect.Descriptor instead.
+func (*ListPageResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{45}
+}
+
+func (m *ListPageResponse) GetResponse() isListPageResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ListPageResponse) GetPage() *ListPage {
+	if x, ok := x.GetResponse().(*ListPageResponse_Page); ok {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListPageResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*ListPageResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isListPageResponse_Response interface {
+	isListPageResponse_Response()
+}
+
+type ListPageResponse_Page struct {
+	Page *ListPage `protobuf:"bytes,1,opt,name=page,proto3,oneof"`
+}
+
+type ListPageResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ListPageResponse_Page) isListPageResponse_Response() {}
+
+func (*ListPageResponse_Error) isListPageResponse_Response() {}
+
+type MediaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*MediaResponse_Media
+	//	*MediaResponse_Error
+	Response isMediaResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *MediaResponse) Reset() {
+	*x = MediaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaResponse) ProtoMessage() {}
+
+func (x *MediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HomeTimelineRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MentionTimelineRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserTimelineRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishTweetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaResponse.ProtoReflect.Descriptor instead.
+func (*MediaResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{46}
+}
+
+func (m *MediaResponse) GetResponse() isMediaResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *MediaResponse) GetMedia() *UploadedMedia {
+	if x, ok := x.GetResponse().(*MediaResponse_Media); ok {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *MediaResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*MediaResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isMediaResponse_Response interface {
+	isMediaResponse_Response()
+}
+
+type MediaResponse_Media struct {
+	Media *UploadedMedia `protobuf:"bytes,1,opt,name=media,proto3,oneof"`
+}
+
+type MediaResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*MediaResponse_Media) isMediaResponse_Response() {}
+
+func (*MediaResponse_Error) isMediaResponse_Response() {}
+
+type Tweets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tweets []*Tweet `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+}
+
+func (x *Tweets) Reset() {
+	*x = Tweets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tweets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tweets) ProtoMessage() {}
+
+func (x *Tweets) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProfileRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tweets.ProtoReflect.Descriptor instead.
+func (*Tweets) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Tweets) GetTweets() []*Tweet {
+	if x != nil {
+		return x.Tweets
+	}
+	return nil
+}
+
+type Users struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *Users) Reset() {
+	*x = Users{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Users) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Users) ProtoMessage() {}
+
+func (x *Users) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TweetResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Users.ProtoReflect.Descriptor instead.
+func (*Users) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Users) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// / A next_cursor of zero indicates that this is the last page
+type UserIdPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids            []uint64 `protobuf:"fixed64,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	NextCursor     int64    `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PreviousCursor int64    `protobuf:"varint,3,opt,name=previous_cursor,json=previousCursor,proto3" json:"previous_cursor,omitempty"`
+}
+
+func (x *UserIdPage) Reset() {
+	*x = UserIdPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserIdPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIdPage) ProtoMessage() {}
+
+func (x *UserIdPage) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TweetsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIdPage.ProtoReflect.Descriptor instead.
+func (*UserIdPage) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UserIdPage) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *UserIdPage) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *UserIdPage) GetPreviousCursor() int64 {
+	if x != nil {
+		return x.PreviousCursor
+	}
+	return 0
+}
+
+// / A next_cursor of zero indicates that this is the last page
+type UserPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users          []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursor     int64   `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PreviousCursor int64   `protobuf:"varint,3,opt,name=previous_cursor,json=previousCursor,proto3" json:"previous_cursor,omitempty"`
+}
+
+func (x *UserPage) Reset() {
+	*x = UserPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPage) ProtoMessage() {}
+
+func (x *UserPage) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_twitter1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tweets); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tweet); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*URL); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Symbol); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mention); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Media); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Poll); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawAPIRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawAPIResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tweet_ReplyData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Media_Size); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_twitter1_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Poll_Option); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPage.ProtoReflect.Descriptor instead.
+func (*UserPage) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UserPage) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *UserPage) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *UserPage) GetPreviousCursor() int64 {
+	if x != nil {
+		return x.PreviousCursor
+	}
+	return 0
+}
+
+type Tweet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   uint64           `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt            int64            `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Text                 string           `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	TextDisplayRange     *Indices         `protobuf:"bytes,4,opt,name=text_display_range,json=textDisplayRange,proto3" json:"text_display_range,omitempty"`
+	Truncated            bool             `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Source               string           `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	User                 *User            `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
+	RepliedTweet         *Tweet_ReplyData `protobuf:"bytes,8,opt,name=replied_tweet,json=repliedTweet,proto3" json:"replied_tweet,omitempty"`
+	QuotedTweet          *Tweet           `protobuf:"bytes,9,opt,name=quoted_tweet,json=quotedTweet,proto3" json:"quoted_tweet,omitempty"`
+	RetweetedTweet       *Tweet           `protobuf:"bytes,10,opt,name=retweeted_tweet,json=retweetedTweet,proto3" json:"retweeted_tweet,omitempty"`
+	QuoteCount           uint32           `protobuf:"varint,11,opt,name=quote_count,json=quoteCount,proto3" json:"quote_count,omitempty"`
+	ReplyCount           uint32           `protobuf:"varint,12,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	RetweetCount         uint32           `protobuf:"varint,13,opt,name=retweet_count,json=retweetCount,proto3" json:"retweet_count,omitempty"`
+	FavoriteCount        uint32           `protobuf:"varint,14,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	Favorited            bool             `protobuf:"varint,15,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	Retweeted            bool             `protobuf:"varint,16,opt,name=retweeted,proto3" json:"retweeted,omitempty"`
+	CurrentUserRetweetId *OptFixed64      `protobuf:"bytes,17,opt,name=current_user_retweet_id,json=currentUserRetweetId,proto3" json:"current_user_retweet_id,omitempty"`
+	Hashtags             []*Symbol        `protobuf:"bytes,18,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	Urls                 []*URL           `protobuf:"bytes,19,rep,name=urls,proto3" json:"urls,omitempty"`
+	Mentions             []*Mention       `protobuf:"bytes,20,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Symbols              []*Symbol        `protobuf:"bytes,21,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	Media                []*Media         `protobuf:"bytes,22,rep,name=media,proto3" json:"media,omitempty"`
+	Polls                []*Poll          `protobuf:"bytes,23,rep,name=polls,proto3" json:"polls,omitempty"`
+	PossiblySensitive    bool             `protobuf:"varint,24,opt,name=possibly_sensitive,json=possiblySensitive,proto3" json:"possibly_sensitive,omitempty"`
+	FilterLevel          string           `protobuf:"bytes,25,opt,name=filter_level,json=filterLevel,proto3" json:"filter_level,omitempty"`
+	Lang                 string           `protobuf:"bytes,26,opt,name=lang,proto3" json:"lang,omitempty"`
+	WithheldCopyright    bool             `protobuf:"varint,27,opt,name=withheld_copyright,json=withheldCopyright,proto3" json:"withheld_copyright,omitempty"`
+	WithheldCountries    []string         `protobuf:"bytes,28,rep,name=withheld_countries,json=withheldCountries,proto3" json:"withheld_countries,omitempty"`
+	WithheldScope        string           `protobuf:"bytes,29,opt,name=withheld_scope,json=withheldScope,proto3" json:"withheld_scope,omitempty"`
+}
+
+func (x *Tweet) Reset() {
+	*x = Tweet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tweet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tweet) ProtoMessage() {}
+
+func (x *Tweet) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tweet.ProtoReflect.Descriptor instead.
+func (*Tweet) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Tweet) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tweet) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Tweet) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Tweet) GetTextDisplayRange() *Indices {
+	if x != nil {
+		return x.TextDisplayRange
+	}
+	return nil
+}
+
+func (x *Tweet) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *Tweet) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Tweet) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Tweet) GetRepliedTweet() *Tweet_ReplyData {
+	if x != nil {
+		return x.RepliedTweet
+	}
+	return nil
+}
+
+func (x *Tweet) GetQuotedTweet() *Tweet {
+	if x != nil {
+		return x.QuotedTweet
+	}
+	return nil
+}
+
+func (x *Tweet) GetRetweetedTweet() *Tweet {
+	if x != nil {
+		return x.RetweetedTweet
+	}
+	return nil
+}
+
+func (x *Tweet) GetQuoteCount() uint32 {
+	if x != nil {
+		return x.QuoteCount
+	}
+	return 0
+}
+
+func (x *Tweet) GetReplyCount() uint32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Tweet) GetRetweetCount() uint32 {
+	if x != nil {
+		return x.RetweetCount
+	}
+	return 0
+}
+
+func (x *Tweet) GetFavoriteCount() uint32 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *Tweet) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+func (x *Tweet) GetRetweeted() bool {
+	if x != nil {
+		return x.Retweeted
+	}
+	return false
+}
+
+func (x *Tweet) GetCurrentUserRetweetId() *OptFixed64 {
+	if x != nil {
+		return x.CurrentUserRetweetId
+	}
+	return nil
+}
+
+func (x *Tweet) GetHashtags() []*Symbol {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *Tweet) GetUrls() []*URL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *Tweet) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Tweet) GetSymbols() []*Symbol {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *Tweet) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Tweet) GetPolls() []*Poll {
+	if x != nil {
+		return x.Polls
+	}
+	return nil
+}
+
+func (x *Tweet) GetPossiblySensitive() bool {
+	if x != nil {
+		return x.PossiblySensitive
+	}
+	return false
+}
+
+func (x *Tweet) GetFilterLevel() string {
+	if x != nil {
+		return x.FilterLevel
+	}
+	return ""
+}
+
+func (x *Tweet) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *Tweet) GetWithheldCopyright() bool {
+	if x != nil {
+		return x.WithheldCopyright
+	}
+	return false
+}
+
+func (x *Tweet) GetWithheldCountries() []string {
+	if x != nil {
+		return x.WithheldCountries
+	}
+	return nil
+}
+
+func (x *Tweet) GetWithheldScope() string {
+	if x != nil {
+		return x.WithheldScope
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tweets   []*Tweet        `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	Metadata *SearchMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SearchResult) GetTweets() []*Tweet {
+	if x != nil {
+		return x.Tweets
+	}
+	return nil
+}
+
+func (x *SearchResult) GetMetadata() *SearchMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// / The search metadata reported by Twitter. If the proxy fetched several pages, this is the metadata of the
+// / last page
+type SearchMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	/// The query string to get the next page of older results, or empty if there are no more results
+	NextResults string `protobuf:"bytes,1,opt,name=next_results,json=nextResults,proto3" json:"next_results,omitempty"`
+	/// The query string to get results newer than these
+	RefreshUrl  string  `protobuf:"bytes,2,opt,name=refresh_url,json=refreshUrl,proto3" json:"refresh_url,omitempty"`
+	MaxId       uint64  `protobuf:"fixed64,3,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	SinceId     uint64  `protobuf:"fixed64,4,opt,name=since_id,json=sinceId,proto3" json:"since_id,omitempty"`
+	Count       uint32  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	CompletedIn float64 `protobuf:"fixed64,6,opt,name=completed_in,json=completedIn,proto3" json:"completed_in,omitempty"`
+	Query       string  `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
+	/// The max_id to use in the TimelineOptions of the next search to get the next page of older results.
+	/// Not present if there are no more results
+	NextMaxId *OptFixed64 `protobuf:"bytes,8,opt,name=next_max_id,json=nextMaxId,proto3" json:"next_max_id,omitempty"`
+}
+
+func (x *SearchMetadata) Reset() {
+	*x = SearchMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMetadata) ProtoMessage() {}
+
+func (x *SearchMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMetadata.ProtoReflect.Descriptor instead.
+func (*SearchMetadata) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SearchMetadata) GetNextResults() string {
+	if x != nil {
+		return x.NextResults
+	}
+	return ""
+}
+
+func (x *SearchMetadata) GetRefreshUrl() string {
+	if x != nil {
+		return x.RefreshUrl
+	}
+	return ""
+}
+
+func (x *SearchMetadata) GetMaxId() uint64 {
+	if x != nil {
+		return x.MaxId
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetSinceId() uint64 {
+	if x != nil {
+		return x.SinceId
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetCompletedIn() float64 {
+	if x != nil {
+		return x.CompletedIn
+	}
+	return 0
+}
+
+func (x *SearchMetadata) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMetadata) GetNextMaxId() *OptFixed64 {
+	if x != nil {
+		return x.NextMaxId
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  uint64   `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	Handle              string   `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	DisplayName         string   `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CreatedAt           int64    `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Bio                 string   `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	Url                 string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Location            string   `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Protected           bool     `protobuf:"varint,8,opt,name=protected,proto3" json:"protected,omitempty"`
+	Verified            bool     `protobuf:"varint,9,opt,name=verified,proto3" json:"verified,omitempty"`
+	FollowerCount       uint32   `protobuf:"varint,10,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	FollowingCount      uint32   `protobuf:"varint,11,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	ListedCount         uint32   `protobuf:"varint,12,opt,name=listed_count,json=listedCount,proto3" json:"listed_count,omitempty"`
+	FavoritesCount      uint32   `protobuf:"varint,13,opt,name=favorites_count,json=favoritesCount,proto3" json:"favorites_count,omitempty"`
+	StatusesCount       uint32   `protobuf:"varint,14,opt,name=statuses_count,json=statusesCount,proto3" json:"statuses_count,omitempty"`
+	ProfileBanner       string   `protobuf:"bytes,15,opt,name=profile_banner,json=profileBanner,proto3" json:"profile_banner,omitempty"`
+	ProfileImage        string   `protobuf:"bytes,16,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
+	DefaultProfile      bool     `protobuf:"varint,17,opt,name=default_profile,json=defaultProfile,proto3" json:"default_profile,omitempty"`
+	DefaultProfileImage bool     `protobuf:"varint,18,opt,name=default_profile_image,json=defaultProfileImage,proto3" json:"default_profile_image,omitempty"`
+	WithheldCountries   []string `protobuf:"bytes,19,rep,name=withheld_countries,json=withheldCountries,proto3" json:"withheld_countries,omitempty"`
+	WithheldScope       string   `protobuf:"bytes,20,opt,name=withheld_scope,json=withheldScope,proto3" json:"withheld_scope,omitempty"`
+	UrlUrls             []*URL   `protobuf:"bytes,21,rep,name=url_urls,json=urlUrls,proto3" json:"url_urls,omitempty"`
+	BioUrls             []*URL   `protobuf:"bytes,22,rep,name=bio_urls,json=bioUrls,proto3" json:"bio_urls,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *User) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *User) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *User) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *User) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *User) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *User) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *User) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *User) GetFollowerCount() uint32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *User) GetFollowingCount() uint32 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+func (x *User) GetListedCount() uint32 {
+	if x != nil {
+		return x.ListedCount
+	}
+	return 0
+}
+
+func (x *User) GetFavoritesCount() uint32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
+func (x *User) GetStatusesCount() uint32 {
+	if x != nil {
+		return x.StatusesCount
+	}
+	return 0
+}
+
+func (x *User) GetProfileBanner() string {
+	if x != nil {
+		return x.ProfileBanner
+	}
+	return ""
+}
+
+func (x *User) GetProfileImage() string {
+	if x != nil {
+		return x.ProfileImage
+	}
+	return ""
+}
+
+func (x *User) GetDefaultProfile() bool {
+	if x != nil {
+		return x.DefaultProfile
+	}
+	return false
+}
+
+func (x *User) GetDefaultProfileImage() bool {
+	if x != nil {
+		return x.DefaultProfileImage
+	}
+	return false
+}
+
+func (x *User) GetWithheldCountries() []string {
+	if x != nil {
+		return x.WithheldCountries
+	}
+	return nil
+}
+
+func (x *User) GetWithheldScope() string {
+	if x != nil {
+		return x.WithheldScope
+	}
+	return ""
+}
+
+func (x *User) GetUrlUrls() []*URL {
+	if x != nil {
+		return x.UrlUrls
+	}
+	return nil
+}
+
+func (x *User) GetBioUrls() []*URL {
+	if x != nil {
+		return x.BioUrls
+	}
+	return nil
+}
+
+// / The authenticated user's relationship with another user
+type Relationship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     uint64 `protobuf:"fixed64,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserHandle string `protobuf:"bytes,2,opt,name=user_handle,json=userHandle,proto3" json:"user_handle,omitempty"`
+	/// Not present in the responses to GetRelationship, since friendships/show only identifies the user
+	User               *User `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Following          bool  `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	FollowingRequested bool  `protobuf:"varint,5,opt,name=following_requested,json=followingRequested,proto3" json:"following_requested,omitempty"`
+	/// Not present in the responses to follow and unfollow requests, since Twitter does not report it there
+	Blocking *OptBool `protobuf:"bytes,6,opt,name=blocking,proto3" json:"blocking,omitempty"`
+	/// Not present in the responses to follow, unfollow, block and unblock requests, since Twitter does not
+	/// report it there
+	Muting               *OptBool `protobuf:"bytes,7,opt,name=muting,proto3" json:"muting,omitempty"`
+	NotificationsEnabled bool     `protobuf:"varint,8,opt,name=notifications_enabled,json=notificationsEnabled,proto3" json:"notifications_enabled,omitempty"`
+	/// Only reported by GetRelationship
+	FollowedBy bool `protobuf:"varint,9,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	/// Only reported by GetRelationship
+	CanDm bool `protobuf:"varint,10,opt,name=can_dm,json=canDm,proto3" json:"can_dm,omitempty"`
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Relationship) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Relationship) GetUserHandle() string {
+	if x != nil {
+		return x.UserHandle
+	}
+	return ""
+}
+
+func (x *Relationship) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Relationship) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowingRequested() bool {
+	if x != nil {
+		return x.FollowingRequested
+	}
+	return false
+}
+
+func (x *Relationship) GetBlocking() *OptBool {
+	if x != nil {
+		return x.Blocking
+	}
+	return nil
+}
+
+func (x *Relationship) GetMuting() *OptBool {
+	if x != nil {
+		return x.Muting
+	}
+	return nil
+}
+
+func (x *Relationship) GetNotificationsEnabled() bool {
+	if x != nil {
+		return x.NotificationsEnabled
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+func (x *Relationship) GetCanDm() bool {
+	if x != nil {
+		return x.CanDm
+	}
+	return false
+}
+
+type DirectMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64     `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   int64      `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SenderId    uint64     `protobuf:"fixed64,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId uint64     `protobuf:"fixed64,4,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Text        string     `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	Hashtags    []*Symbol  `protobuf:"bytes,6,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	Urls        []*URL     `protobuf:"bytes,7,rep,name=urls,proto3" json:"urls,omitempty"`
+	Mentions    []*Mention `protobuf:"bytes,8,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Symbols     []*Symbol  `protobuf:"bytes,9,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	Media       []*Media   `protobuf:"bytes,10,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *DirectMessage) Reset() {
+	*x = DirectMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectMessage) ProtoMessage() {}
+
+func (x *DirectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectMessage.ProtoReflect.Descriptor instead.
+func (*DirectMessage) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DirectMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DirectMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DirectMessage) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *DirectMessage) GetRecipientId() uint64 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *DirectMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DirectMessage) GetHashtags() []*Symbol {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetUrls() []*URL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetSymbols() []*Symbol {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// / An empty next_cursor indicates that this is the last page
+type DirectMessagePage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages   []*DirectMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextCursor string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *DirectMessagePage) Reset() {
+	*x = DirectMessagePage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectMessagePage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectMessagePage) ProtoMessage() {}
+
+func (x *DirectMessagePage) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectMessagePage.ProtoReflect.Descriptor instead.
+func (*DirectMessagePage) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DirectMessagePage) GetMessages() []*DirectMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *DirectMessagePage) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug            string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	FullName        string `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Description     string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt       int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Private         bool   `protobuf:"varint,7,opt,name=private,proto3" json:"private,omitempty"`
+	MemberCount     uint32 `protobuf:"varint,8,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	SubscriberCount uint32 `protobuf:"varint,9,opt,name=subscriber_count,json=subscriberCount,proto3" json:"subscriber_count,omitempty"`
+	Following       bool   `protobuf:"varint,10,opt,name=following,proto3" json:"following,omitempty"`
+	Owner           *User  `protobuf:"bytes,11,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *List) Reset() {
+	*x = List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*List) ProtoMessage() {}
+
+func (x *List) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use List.ProtoReflect.Descriptor instead.
+func (*List) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *List) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *List) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *List) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *List) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *List) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *List) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *List) GetMemberCount() uint32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *List) GetSubscriberCount() uint32 {
+	if x != nil {
+		return x.SubscriberCount
+	}
+	return 0
+}
+
+func (x *List) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *List) GetOwner() *User {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// / A next_cursor of zero indicates that this is the last page
+type ListPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lists          []*List `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	NextCursor     int64   `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PreviousCursor int64   `protobuf:"varint,3,opt,name=previous_cursor,json=previousCursor,proto3" json:"previous_cursor,omitempty"`
+}
+
+func (x *ListPage) Reset() {
+	*x = ListPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPage) ProtoMessage() {}
+
+func (x *ListPage) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPage.ProtoReflect.Descriptor instead.
+func (*ListPage) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListPage) GetLists() []*List {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+func (x *ListPage) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListPage) GetPreviousCursor() int64 {
+	if x != nil {
+		return x.PreviousCursor
+	}
+	return 0
+}
+
+type URL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices     *Indices `protobuf:"bytes,1,opt,name=indices,proto3" json:"indices,omitempty"`
+	TwitterUrl  string   `protobuf:"bytes,2,opt,name=twitter_url,json=twitterUrl,proto3" json:"twitter_url,omitempty"`
+	DisplayUrl  string   `protobuf:"bytes,3,opt,name=display_url,json=displayUrl,proto3" json:"display_url,omitempty"`
+	ExpandedUrl string   `protobuf:"bytes,4,opt,name=expanded_url,json=expandedUrl,proto3" json:"expanded_url,omitempty"`
+}
+
+func (x *URL) Reset() {
+	*x = URL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *URL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*URL) ProtoMessage() {}
+
+func (x *URL) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use URL.ProtoReflect.Descriptor instead.
+func (*URL) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *URL) GetIndices() *Indices {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *URL) GetTwitterUrl() string {
+	if x != nil {
+		return x.TwitterUrl
+	}
+	return ""
+}
+
+func (x *URL) GetDisplayUrl() string {
+	if x != nil {
+		return x.DisplayUrl
+	}
+	return ""
+}
+
+func (x *URL) GetExpandedUrl() string {
+	if x != nil {
+		return x.ExpandedUrl
+	}
+	return ""
+}
+
+type Symbol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices *Indices `protobuf:"bytes,1,opt,name=indices,proto3" json:"indices,omitempty"`
+	Text    string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Symbol) Reset() {
+	*x = Symbol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Symbol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Symbol) ProtoMessage() {}
+
+func (x *Symbol) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Symbol.ProtoReflect.Descriptor instead.
+func (*Symbol) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Symbol) GetIndices() *Indices {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *Symbol) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Mention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices     *Indices `protobuf:"bytes,1,opt,name=indices,proto3" json:"indices,omitempty"`
+	UserId      uint64   `protobuf:"fixed64,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Handle      string   `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
+	DisplayName string   `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Mention) GetIndices() *Indices {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *Mention) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Mention) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Mention) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type Media struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url           *URL        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id            uint64      `protobuf:"fixed64,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MediaUrl      string      `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	Alt           string      `protobuf:"bytes,5,opt,name=alt,proto3" json:"alt,omitempty"`
+	SourceTweetId *OptFixed64 `protobuf:"bytes,6,opt,name=source_tweet_id,json=sourceTweetId,proto3" json:"source_tweet_id,omitempty"`
+	Thumb         *Media_Size `protobuf:"bytes,7,opt,name=thumb,proto3" json:"thumb,omitempty"`
+	Small         *Media_Size `protobuf:"bytes,8,opt,name=small,proto3" json:"small,omitempty"`
+	Medium        *Media_Size `protobuf:"bytes,9,opt,name=medium,proto3" json:"medium,omitempty"`
+	Large         *Media_Size `protobuf:"bytes,10,opt,name=large,proto3" json:"large,omitempty"`
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Media) GetUrl() *URL {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *Media) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Media) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Media) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *Media) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+func (x *Media) GetSourceTweetId() *OptFixed64 {
+	if x != nil {
+		return x.SourceTweetId
+	}
+	return nil
+}
+
+func (x *Media) GetThumb() *Media_Size {
+	if x != nil {
+		return x.Thumb
+	}
+	return nil
+}
+
+func (x *Media) GetSmall() *Media_Size {
+	if x != nil {
+		return x.Small
+	}
+	return nil
+}
+
+func (x *Media) GetMedium() *Media_Size {
+	if x != nil {
+		return x.Medium
+	}
+	return nil
+}
+
+func (x *Media) GetLarge() *Media_Size {
+	if x != nil {
+		return x.Large
+	}
+	return nil
+}
+
+type UploadedMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size             uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ExpiresAfterSecs int64  `protobuf:"varint,3,opt,name=expires_after_secs,json=expiresAfterSecs,proto3" json:"expires_after_secs,omitempty"`
+	/// Only present for media which requires asynchronous processing, such as videos and GIFs
+	Processing *UploadedMedia_Processing `protobuf:"bytes,4,opt,name=processing,proto3" json:"processing,omitempty"`
+}
+
+func (x *UploadedMedia) Reset() {
+	*x = UploadedMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadedMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedMedia) ProtoMessage() {}
+
+func (x *UploadedMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedMedia.ProtoReflect.Descriptor instead.
+func (*UploadedMedia) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UploadedMedia) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UploadedMedia) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadedMedia) GetExpiresAfterSecs() int64 {
+	if x != nil {
+		return x.ExpiresAfterSecs
+	}
+	return 0
+}
+
+func (x *UploadedMedia) GetProcessing() *UploadedMedia_Processing {
+	if x != nil {
+		return x.Processing
+	}
+	return nil
+}
+
+type Poll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndTime         int64          `protobuf:"varint,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	DurationMinutes uint32         `protobuf:"varint,2,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	Options         []*Poll_Option `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Poll) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *Poll) GetDurationMinutes() uint32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Poll) GetOptions() []*Poll_Option {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type RawAPIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	/// The HTTP method to use; defaults to GET if empty
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	/// Currently ignored; the protocol and base URL configured for the proxy are always used
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	/// Currently ignored; the protocol and base URL configured for the proxy are always used
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	/// The path of the endpoint relative to the API base URL, e.g. "statuses/show.json"
+	Path        string            `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	QueryParams map[string]string `protobuf:"bytes,6,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BodyParams  map[string]string `protobuf:"bytes,7,rep,name=body_params,json=bodyParams,proto3" json:"body_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *RawAPIRequest) Reset() {
+	*x = RawAPIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawAPIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawAPIRequest) ProtoMessage() {}
+
+func (x *RawAPIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawAPIRequest.ProtoReflect.Descriptor instead.
+func (*RawAPIRequest) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RawAPIRequest) GetAuth() *Authentication {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *RawAPIRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RawAPIRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *RawAPIRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RawAPIRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RawAPIRequest) GetQueryParams() map[string]string {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+func (x *RawAPIRequest) GetBodyParams() map[string]string {
+	if x != nil {
+		return x.BodyParams
+	}
+	return nil
+}
+
+type RawAPIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*RawAPIResponse_Result
+	//	*RawAPIResponse_Error
+	Response isRawAPIResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RawAPIResponse) Reset() {
+	*x = RawAPIResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawAPIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawAPIResponse) ProtoMessage() {}
+
+func (x *RawAPIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawAPIResponse.ProtoReflect.Descriptor instead.
+func (*RawAPIResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{67}
+}
+
+func (m *RawAPIResponse) GetResponse() isRawAPIResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RawAPIResponse) GetResult() *RawAPIResult {
+	if x, ok := x.GetResponse().(*RawAPIResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RawAPIResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*RawAPIResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRawAPIResponse_Response interface {
+	isRawAPIResponse_Response()
+}
+
+type RawAPIResponse_Result struct {
+	Result *RawAPIResult `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+}
+
+type RawAPIResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RawAPIResponse_Result) isRawAPIResponse_Response() {}
+
+func (*RawAPIResponse_Error) isRawAPIResponse_Response() {}
+
+type RateLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*RateLimitsResponse_Limits
+	//	*RateLimitsResponse_Error
+	Response isRateLimitsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RateLimitsResponse) Reset() {
+	*x = RateLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitsResponse) ProtoMessage() {}
+
+func (x *RateLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitsResponse.ProtoReflect.Descriptor instead.
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{68}
+}
+
+func (m *RateLimitsResponse) GetResponse() isRateLimitsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RateLimitsResponse) GetLimits() *RateLimits {
+	if x, ok := x.GetResponse().(*RateLimitsResponse_Limits); ok {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *RateLimitsResponse) GetError() *Error {
+	if x, ok := x.GetResponse().(*RateLimitsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRateLimitsResponse_Response interface {
+	isRateLimitsResponse_Response()
+}
+
+type RateLimitsResponse_Limits struct {
+	Limits *RateLimits `protobuf:"bytes,1,opt,name=limits,proto3,oneof"`
+}
+
+type RateLimitsResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RateLimitsResponse_Limits) isRateLimitsResponse_Response() {}
+
+func (*RateLimitsResponse_Error) isRateLimitsResponse_Response() {}
+
+type RawAPIResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Status  uint32            `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Body    []byte            `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *RawAPIResult) Reset() {
+	*x = RawAPIResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawAPIResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawAPIResult) ProtoMessage() {}
+
+func (x *RawAPIResult) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawAPIResult.ProtoReflect.Descriptor instead.
+func (*RawAPIResult) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RawAPIResult) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RawAPIResult) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RawAPIResult) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type RateLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits []*RateLimit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *RateLimits) Reset() {
+	*x = RateLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimits) ProtoMessage() {}
+
+func (x *RateLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimits.ProtoReflect.Descriptor instead.
+func (*RateLimits) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RateLimits) GetLimits() []*RateLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// / The proxy's view of one of Twitter's rate limits for an access token
+type RateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	/// Either "group:" followed by the name of a group of endpoints which share a limit (e.g. "group:publish"),
+	/// or "singleton:" followed by the path of an endpoint (e.g. "singleton:statuses/show.json")
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	/// The number of requests left in the current window, if known
+	Current *OptUint64 `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	/// The number of requests allowed in the next window, if known
+	Next *OptUint64 `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+	/// Unix time at which the current window ends, or zero if unknown
+	Resets int64 `protobuf:"varint,4,opt,name=resets,proto3" json:"resets,omitempty"`
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RateLimit) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RateLimit) GetCurrent() *OptUint64 {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *RateLimit) GetNext() *OptUint64 {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+func (x *RateLimit) GetResets() int64 {
+	if x != nil {
+		return x.Resets
+	}
+	return 0
+}
+
+type MediaChunk_Init struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth             *Authentication `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	TotalBytes       uint64          `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	MediaType        string          `protobuf:"bytes,3,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	MediaCategory    string          `protobuf:"bytes,4,opt,name=media_category,json=mediaCategory,proto3" json:"media_category,omitempty"`
+	AdditionalOwners []uint64        `protobuf:"fixed64,5,rep,packed,name=additional_owners,json=additionalOwners,proto3" json:"additional_owners,omitempty"`
+	/// If set, the proxy does not respond until Twitter has finished processing the media
+	WaitForProcessing bool `protobuf:"varint,6,opt,name=wait_for_processing,json=waitForProcessing,proto3" json:"wait_for_processing,omitempty"`
+}
+
+func (x *MediaChunk_Init) Reset() {
+	*x = MediaChunk_Init{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaChunk_Init) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaChunk_Init) ProtoMessage() {}
+
+func (x *MediaChunk_Init) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaChunk_Init.ProtoReflect.Descriptor instead.
+func (*MediaChunk_Init) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *MediaChunk_Init) GetAuth() *Authentication {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *MediaChunk_Init) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *MediaChunk_Init) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *MediaChunk_Init) GetMediaCategory() string {
+	if x != nil {
+		return x.MediaCategory
+	}
+	return ""
+}
+
+func (x *MediaChunk_Init) GetAdditionalOwners() []uint64 {
+	if x != nil {
+		return x.AdditionalOwners
+	}
+	return nil
+}
+
+func (x *MediaChunk_Init) GetWaitForProcessing() bool {
+	if x != nil {
+		return x.WaitForProcessing
+	}
+	return false
+}
+
+type Tweet_ReplyData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplyToTweetId    uint64 `protobuf:"fixed64,1,opt,name=reply_to_tweet_id,json=replyToTweetId,proto3" json:"reply_to_tweet_id,omitempty"`
+	ReplyToUserId     uint64 `protobuf:"fixed64,2,opt,name=reply_to_user_id,json=replyToUserId,proto3" json:"reply_to_user_id,omitempty"`
+	ReplyToUserHandle string `protobuf:"bytes,3,opt,name=reply_to_user_handle,json=replyToUserHandle,proto3" json:"reply_to_user_handle,omitempty"`
+}
+
+func (x *Tweet_ReplyData) Reset() {
+	*x = Tweet_ReplyData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tweet_ReplyData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tweet_ReplyData) ProtoMessage() {}
+
+func (x *Tweet_ReplyData) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tweet_ReplyData.ProtoReflect.Descriptor instead.
+func (*Tweet_ReplyData) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *Tweet_ReplyData) GetReplyToTweetId() uint64 {
+	if x != nil {
+		return x.ReplyToTweetId
+	}
+	return 0
+}
+
+func (x *Tweet_ReplyData) GetReplyToUserId() uint64 {
+	if x != nil {
+		return x.ReplyToUserId
+	}
+	return 0
+}
+
+func (x *Tweet_ReplyData) GetReplyToUserHandle() string {
+	if x != nil {
+		return x.ReplyToUserHandle
+	}
+	return ""
+}
+
+type Media_Size struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width  uint32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Resize string `protobuf:"bytes,3,opt,name=resize,proto3" json:"resize,omitempty"`
+}
+
+func (x *Media_Size) Reset() {
+	*x = Media_Size{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Media_Size) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media_Size) ProtoMessage() {}
+
+func (x *Media_Size) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media_Size.ProtoReflect.Descriptor instead.
+func (*Media_Size) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{63, 0}
+}
+
+func (x *Media_Size) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Media_Size) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Media_Size) GetResize() string {
+	if x != nil {
+		return x.Resize
+	}
+	return ""
+}
+
+type UploadedMedia_Processing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State           UploadedMedia_Processing_State `protobuf:"varint,1,opt,name=state,proto3,enum=twitter1.UploadedMedia_Processing_State" json:"state,omitempty"`
+	CheckAfterSecs  int64                          `protobuf:"varint,2,opt,name=check_after_secs,json=checkAfterSecs,proto3" json:"check_after_secs,omitempty"`
+	ProgressPercent uint32                         `protobuf:"varint,3,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	Error           string                         `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UploadedMedia_Processing) Reset() {
+	*x = UploadedMedia_Processing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadedMedia_Processing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedMedia_Processing) ProtoMessage() {}
+
+func (x *UploadedMedia_Processing) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedMedia_Processing.ProtoReflect.Descriptor instead.
+func (*UploadedMedia_Processing) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *UploadedMedia_Processing) GetState() UploadedMedia_Processing_State {
+	if x != nil {
+		return x.State
+	}
+	return UploadedMedia_Processing_PENDING
+}
+
+func (x *UploadedMedia_Processing) GetCheckAfterSecs() int64 {
+	if x != nil {
+		return x.CheckAfterSecs
+	}
+	return 0
+}
+
+func (x *UploadedMedia_Processing) GetProgressPercent() uint32 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *UploadedMedia_Processing) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Poll_Option struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position uint32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Poll_Option) Reset() {
+	*x = Poll_Option{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_twitter1_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Poll_Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll_Option) ProtoMessage() {}
+
+func (x *Poll_Option) ProtoReflect() protoreflect.Message {
+	mi := &file_twitter1_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll_Option.ProtoReflect.Descriptor instead.
+func (*Poll_Option) Descriptor() ([]byte, []int) {
+	return file_twitter1_proto_rawDescGZIP(), []int{65, 0}
+}
+
+func (x *Poll_Option) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Poll_Option) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+var File_twitter1_proto protoreflect.FileDescriptor
+
+var file_twitter1_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x22, 0x1c, 0x0a, 0x08, 0x4f, 0x70,
+	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x09, 0x4f, 0x70, 0x74, 0x55,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x1e, 0x0a, 0x0a, 0x4f, 0x70, 0x74, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x09, 0x4f, 0x70, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x1b, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x42, 0x6f, 0x6f,
+	0x6c, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x76, 0x61, 0x6c, 0x22, 0xb1, 0x02, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e,
+	0x0a, 0x0a, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x54, 0x57, 0x49, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58,
+	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52,
+	0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x55, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x08, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x31, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xb2, 0x02, 0x0a, 0x0c, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x72, 0x69, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d,
+	0x79, 0x5f, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x79, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x45, 0x78, 0x74, 0x41, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x75, 0x72,
+	0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x55, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x20, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x22, 0xef, 0x01, 0x0a, 0x0f, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x4f, 0x70, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x05, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x0d,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f,
+	0x70, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x7c, 0x0a, 0x0c, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a,
+	0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x7f, 0x0a,
+	0x0d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x06, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x22, 0xd2,
+	0x03, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x67, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x65, 0x6f, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b,
+	0x0a, 0x0f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x0e, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x44, 0x0a, 0x10, 0x74,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x30, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x09, 0x0a, 0x05, 0x4d, 0x49, 0x58, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45,
+	0x43, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x50, 0x55, 0x4c, 0x41,
+	0x52, 0x10, 0x02, 0x22, 0xb2, 0x01, 0x0a, 0x13, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x4d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x19, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x48, 0x00,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x74,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x8e, 0x04,
+	0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19,
+	0x61, 0x75, 0x74, 0x6f, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x16, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x06, 0x52, 0x13, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x3a,
+	0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x06, 0x52, 0x08, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x53, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x64, 0x6d, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x6d, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x6d, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x66, 0x61, 0x69, 0x6c, 0x44, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x70, 0x74, 0x73, 0x22, 0x99,
+	0x02, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x69,
+	0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0b, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x42, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x9c, 0x02, 0x0a, 0x10, 0x55, 0x73,
+	0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x42, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xa5, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x06, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0xf6, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x4f, 0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12,
+	0x32, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f,
+	0x70, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x53, 0x65,
+	0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x52, 0x07, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x06, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0xcd, 0x01, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x77,
+	0x65, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x06, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0e, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22,
+	0xa0, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0xc7, 0x01, 0x0a, 0x11, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xac, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2b,
+	0x0a, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x06, 0x52, 0x10, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xd7, 0x02, 0x0a, 0x0a,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x2f, 0x0a, 0x04, 0x69, 0x6e,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x49,
+	0x6e, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0xf8, 0x01, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x06, 0x52, 0x10, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x61, 0x69, 0x74, 0x46,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x5d, 0x0a, 0x12, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x11, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x6d, 0x0a, 0x0d, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x0e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x48, 0x00, 0x52, 0x06, 0x74, 0x77,
+	0x65, 0x65, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0c, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0x0a,
+	0x0d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x48, 0x00,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x0f,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x50,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x71, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x27,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x81, 0x01, 0x0a, 0x15, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x71, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x0d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x00, 0x52,
+	0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x06, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73, 0x22, 0x2d,
+	0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x68, 0x0a,
+	0x0a, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x50, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x06, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x7a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0xa1, 0x0a, 0x0a, 0x05, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x3f, 0x0a, 0x12, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x10, 0x74, 0x65, 0x78, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0c, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12,
+	0x38, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x77, 0x65,
+	0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x77, 0x65,
+	0x65, 0x74, 0x65, 0x64, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x17, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x4f, 0x70, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x14, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21,
+	0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c,
+	0x73, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2a, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x05,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f, 0x73,
+	0x73, 0x69, 0x62, 0x6c, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x53,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x61, 0x6e, 0x67, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12,
+	0x2d, 0x0a, 0x12, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x70, 0x79,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74,
+	0x68, 0x68, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d,
+	0x0a, 0x12, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68,
+	0x68, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x1a, 0x90, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x29, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x74,
+	0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0e, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x54, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f,
+	0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x55, 0x73, 0x65,
+	0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x6d, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x06, 0x74, 0x77, 0x65, 0x65, 0x74, 0x73,
+	0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8b, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x15, 0x0a,
+	0x06, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05, 0x6d,
+	0x61, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x34,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f,
+	0x70, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x4d,
+	0x61, 0x78, 0x49, 0x64, 0x22, 0x80, 0x06, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a,
+	0x12, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x68,
+	0x65, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x75, 0x72, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18,
+	0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x55, 0x52, 0x4c, 0x52, 0x07, 0x75, 0x72, 0x6c, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x28, 0x0a,
+	0x08, 0x62, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x07,
+	0x62, 0x69, 0x6f, 0x55, 0x72, 0x6c, 0x73, 0x22, 0x82, 0x03, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x13, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x4f, 0x70, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x06, 0x6d, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x33, 0x0a, 0x15, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x5f, 0x64, 0x6d, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x61, 0x6e, 0x44, 0x6d, 0x22, 0xe5, 0x02, 0x0a,
+	0x0d, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x2c, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x21, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72,
+	0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a,
+	0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x22, 0x69, 0x0a, 0x11, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0xc8, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x7a, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x97, 0x01, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x2b,
+	0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x72, 0x6c,
+	0x22, 0x49, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e,
+	0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x52, 0x07,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x07,
+	0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x64,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb9, 0x03, 0x0a, 0x05, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x12, 0x1f, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x55, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x05, 0x74, 0x68, 0x75, 0x6d, 0x62,
+	0x12, 0x2a, 0x0a, 0x05, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x05, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x12, 0x2c, 0x0a, 0x06,
+	0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x53, 0x69,
+	0x7a, 0x65, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x61,
+	0x72, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52,
+	0x05, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x1a, 0x4c, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0xa1, 0x03, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x1a, 0xf9, 0x01, 0x0a,
+	0x0a, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0xb7, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6c,
+	0x6c, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x38, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0xb5, 0x03, 0x0a, 0x0d, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x4b, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x48, 0x0a, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x42, 0x6f, 0x64, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x62, 0x6f, 0x64, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x42,
+	0x6f, 0x64, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x77, 0x0a, 0x0e, 0x52, 0x61,
+	0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x12, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x48,
+	0x00, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb5,
+	0x01, 0x0a, 0x0c, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x3d, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x41,
+	0x50, 0x49, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x39, 0x0a, 0x0a, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x22, 0x8d, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70,
+	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x73, 0x32, 0xca, 0x18, 0x0a, 0x07, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x09, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x55,
+	0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77,
+	0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x52,
+	0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e,
+	0x55, 0x6e, 0x72, 0x65, 0x74, 0x77, 0x65, 0x65, 0x74, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x16,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x1d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x20, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54,
+	0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x1d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x54, 0x77, 0x65, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x77, 0x65, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x4d, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4b,
+	0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x12, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0a, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x15, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x55,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x08, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x15, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x15, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d,
+	0x41, 0x64, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x74,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x12,
+	0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x41, 0x50,
+	0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x77, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
+	0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x12, 0x1d,
+	0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x54, 0x77, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x54, 0x77, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1c, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x65, 0x64, 0x12, 0x14, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31, 0x2e,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x31,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b,
+	0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x6e,
+	0x74, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x72, 0x65, 0x2f, 0x67, 0x6f, 0x6c, 0x64, 0x63, 0x72, 0x65,
+	0x73, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_twitter1_proto_rawDescOnce sync.Once
+	file_twitter1_proto_rawDescData = file_twitter1_proto_rawDesc
+)
+
+func file_twitter1_proto_rawDescGZIP() []byte {
+	file_twitter1_proto_rawDescOnce.Do(func() {
+		file_twitter1_proto_rawDescData = protoimpl.X.CompressGZIP(file_twitter1_proto_rawDescData)
+	})
+	return file_twitter1_proto_rawDescData
+}
+
+var file_twitter1_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_twitter1_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_twitter1_proto_goTypes = []interface{}{
+	(Error_Code)(0),                     // 0: twitter1.Error.Code
+	(TweetOptions_Mode)(0),              // 1: twitter1.TweetOptions.Mode
+	(SearchRequest_ResultType)(0),       // 2: twitter1.SearchRequest.ResultType
+	(UploadedMedia_Processing_State)(0), // 3: twitter1.UploadedMedia.Processing.State
+	(*OptInt64)(nil),                    // 4: twitter1.OptInt64
+	(*OptUint64)(nil),                   // 5: twitter1.OptUint64
+	(*OptFixed64)(nil),                  // 6: twitter1.OptFixed64
+	(*OptString)(nil),                   // 7: twitter1.OptString
+	(*OptBool)(nil),                     // 8: twitter1.OptBool
+	(*Error)(nil),                       // 9: twitter1.Error
+	(*Authentication)(nil),              // 10: twitter1.Authentication
+	(*Indices)(nil),                     // 11: twitter1.Indices
+	(*TweetOptions)(nil),                // 12: twitter1.TweetOptions
+	(*TimelineOptions)(nil),             // 13: twitter1.TimelineOptions
+	(*CursorOptions)(nil),               // 14: twitter1.CursorOptions
+	(*TweetRequest)(nil),                // 15: twitter1.TweetRequest
+	(*TweetsRequest)(nil),               // 16: twitter1.TweetsRequest
+	(*SearchRequest)(nil),               // 17: twitter1.SearchRequest
+	(*HomeTimelineRequest)(nil),         // 18: twitter1.HomeTimelineRequest
+	(*MentionTimelineRequest)(nil),      // 19: twitter1.MentionTimelineRequest
+	(*UserTimelineRequest)(nil),         // 20: twitter1.UserTimelineRequest
+	(*PublishTweetRequest)(nil),         // 21: twitter1.PublishTweetRequest
+	(*StreamTweetsRequest)(nil),         // 22: twitter1.StreamTweetsRequest
+	(*UserRequest)(nil),                 // 23: twitter1.UserRequest
+	(*UserGraphRequest)(nil),            // 24: twitter1.UserGraphRequest
+	(*UsersRequest)(nil),                // 25: twitter1.UsersRequest
+	(*UpdateProfileRequest)(nil),        // 26: twitter1.UpdateProfileRequest
+	(*SendDirectMessageRequest)(nil),    // 27: twitter1.SendDirectMessageRequest
+	(*ListDirectMessagesRequest)(nil),   // 28: twitter1.ListDirectMessagesRequest
+	(*ListRequest)(nil),                 // 29: twitter1.ListRequest
+	(*ListTimelineRequest)(nil),         // 30: twitter1.ListTimelineRequest
+	(*ListMembersRequest)(nil),          // 31: twitter1.ListMembersRequest
+	(*ListMemberRequest)(nil),           // 32: twitter1.ListMemberRequest
+	(*OwnedListsRequest)(nil),           // 33: twitter1.OwnedListsRequest
+	(*UploadMediaRequest)(nil),          // 34: twitter1.UploadMediaRequest
+	(*MediaChunk)(nil),                  // 35: twitter1.MediaChunk
+	(*MediaStatusRequest)(nil),          // 36: twitter1.MediaStatusRequest
+	(*RateLimitsRequest)(nil),           // 37: twitter1.RateLimitsRequest
+	(*TweetResponse)(nil),               // 38: twitter1.TweetResponse
+	(*TweetsResponse)(nil),              // 39: twitter1.TweetsResponse
+	(*UserResponse)(nil),                // 40: twitter1.UserResponse
+	(*SearchResponse)(nil),              // 41: twitter1.SearchResponse
+	(*UsersResponse)(nil),               // 42: twitter1.UsersResponse
+	(*UserIdsResponse)(nil),             // 43: twitter1.UserIdsResponse
+	(*UserPageResponse)(nil),            // 44: twitter1.UserPageResponse
+	(*RelationshipResponse)(nil),        // 45: twitter1.RelationshipResponse
+	(*DirectMessageResponse)(nil),       // 46: twitter1.DirectMessageResponse
+	(*DirectMessagesResponse)(nil),      // 47: twitter1.DirectMessagesResponse
+	(*ListResponse)(nil),                // 48: twitter1.ListResponse
+	(*ListPageResponse)(nil),            // 49: twitter1.ListPageResponse
+	(*MediaResponse)(nil),               // 50: twitter1.MediaResponse
+	(*Tweets)(nil),                      // 51: twitter1.Tweets
+	(*Users)(nil),                       // 52: twitter1.Users
+	(*UserIdPage)(nil),                  // 53: twitter1.UserIdPage
+	(*UserPage)(nil),                    // 54: twitter1.UserPage
+	(*Tweet)(nil),                       // 55: twitter1.Tweet
+	(*SearchResult)(nil),                // 56: twitter1.SearchResult
+	(*SearchMetadata)(nil),              // 57: twitter1.SearchMetadata
+	(*User)(nil),                        // 58: twitter1.User
+	(*Relationship)(nil),                // 59: twitter1.Relationship
+	(*DirectMessage)(nil),               // 60: twitter1.DirectMessage
+	(*DirectMessagePage)(nil),           // 61: twitter1.DirectMessagePage
+	(*List)(nil),                        // 62: twitter1.List
+	(*ListPage)(nil),                    // 63: twitter1.ListPage
+	(*URL)(nil),                         // 64: twitter1.URL
+	(*Symbol)(nil),                      // 65: twitter1.Symbol
+	(*Mention)(nil),                     // 66: twitter1.Mention
+	(*Media)(nil),                       // 67: twitter1.Media
+	(*UploadedMedia)(nil),               // 68: twitter1.UploadedMedia
+	(*Poll)(nil),                        // 69: twitter1.Poll
+	(*RawAPIRequest)(nil),               // 70: twitter1.RawAPIRequest
+	(*RawAPIResponse)(nil),              // 71: twitter1.RawAPIResponse
+	(*RateLimitsResponse)(nil),          // 72: twitter1.RateLimitsResponse
+	(*RawAPIResult)(nil),                // 73: twitter1.RawAPIResult
+	(*RateLimits)(nil),                  // 74: twitter1.RateLimits
+	(*RateLimit)(nil),                   // 75: twitter1.RateLimit
+	(*MediaChunk_Init)(nil),             // 76: twitter1.MediaChunk.Init
+	(*Tweet_ReplyData)(nil),             // 77: twitter1.Tweet.ReplyData
+	(*Media_Size)(nil),                  // 78: twitter1.Media.Size
+	(*UploadedMedia_Processing)(nil),    // 79: twitter1.UploadedMedia.Processing
+	(*Poll_Option)(nil),                 // 80: twitter1.Poll.Option
+	nil,                                 // 81: twitter1.RawAPIRequest.QueryParamsEntry
+	nil,                                 // 82: twitter1.RawAPIRequest.BodyParamsEntry
+	nil,                                 // 83: twitter1.RawAPIResult.HeadersEntry
+}
+var file_twitter1_proto_depIdxs = []int32{
+	0,   // 0: twitter1.Error.code:type_name -> twitter1.Error.Code
+	1,   // 1: twitter1.TweetOptions.mode:type_name -> twitter1.TweetOptions.Mode
+	6,   // 2: twitter1.TimelineOptions.min_id:type_name -> twitter1.OptFixed64
+	6,   // 3: twitter1.TimelineOptions.max_id:type_name -> twitter1.OptFixed64
+	12,  // 4: twitter1.TimelineOptions.twopts:type_name -> twitter1.TweetOptions
+	4,   // 5: twitter1.CursorOptions.cursor:type_name -> twitter1.OptInt64
+	10,  // 6: twitter1.TweetRequest.auth:type_name -> twitter1.Authentication
+	12,  // 7: twitter1.TweetRequest.twopts:type_name -> twitter1.TweetOptions
+	10,  // 8: twitter1.TweetsRequest.auth:type_name -> twitter1.Authentication
+	12,  // 9: twitter1.TweetsRequest.twopts:type_name -> twitter1.TweetOptions
+	10,  // 10: twitter1.SearchRequest.auth:type_name -> twitter1.Authentication
+	7,   // 11: twitter1.SearchRequest.geocode:type_name -> twitter1.OptString
+	7,   // 12: twitter1.SearchRequest.lang:type_name -> twitter1.OptString
+	7,   // 13: twitter1.SearchRequest.locale:type_name -> twitter1.OptString
+	2,   // 14: twitter1.SearchRequest.result_type:type_name -> twitter1.SearchRequest.ResultType
+	4,   // 15: twitter1.SearchRequest.until_timestamp:type_name -> twitter1.OptInt64
+	13,  // 16: twitter1.SearchRequest.timeline_options:type_name -> twitter1.TimelineOptions
+	10,  // 17: twitter1.HomeTimelineRequest.auth:type_name -> twitter1.Authentication
+	13,  // 18: twitter1.HomeTimelineRequest.timeline_options:type_name -> twitter1.TimelineOptions
+	10,  // 19: twitter1.MentionTimelineRequest.auth:type_name -> twitter1.Authentication
+	13,  // 20: twitter1.MentionTimelineRequest.timeline_options:type_name -> twitter1.TimelineOptions
+	10,  // 21: twitter1.UserTimelineRequest.auth:type_name -> twitter1.Authentication
+	13,  // 22: twitter1.UserTimelineRequest.timeline_options:type_name -> twitter1.TimelineOptions
+	10,  // 23: twitter1.PublishTweetRequest.auth:type_name -> twitter1.Authentication
+	6,   // 24: twitter1.PublishTweetRequest.reply_id:type_name -> twitter1.OptFixed64
+	7,   // 25: twitter1.PublishTweetRequest.attachment_url:type_name -> twitter1.OptString
+	12,  // 26: twitter1.PublishTweetRequest.twopts:type_name -> twitter1.TweetOptions
+	17,  // 27: twitter1.StreamTweetsRequest.search:type_name -> twitter1.SearchRequest
+	19,  // 28: twitter1.StreamTweetsRequest.mention_timeline:type_name -> twitter1.MentionTimelineRequest
+	20,  // 29: twitter1.StreamTweetsRequest.user_timeline:type_name -> twitter1.UserTimelineRequest
+	10,  // 30: twitter1.UserRequest.auth:type_name -> twitter1.Authentication
+	10,  // 31: twitter1.UserGraphRequest.auth:type_name -> twitter1.Authentication
+	14,  // 32: twitter1.UserGraphRequest.cursor_options:type_name -> twitter1.CursorOptions
+	10,  // 33: twitter1.UsersRequest.auth:type_name -> twitter1.Authentication
+	10,  // 34: twitter1.UpdateProfileRequest.auth:type_name -> twitter1.Authentication
+	7,   // 35: twitter1.UpdateProfileRequest.name:type_name -> twitter1.OptString
+	7,   // 36: twitter1.UpdateProfileRequest.url:type_name -> twitter1.OptString
+	7,   // 37: twitter1.UpdateProfileRequest.location:type_name -> twitter1.OptString
+	7,   // 38: twitter1.UpdateProfileRequest.bio:type_name -> twitter1.OptString
+	7,   // 39: twitter1.UpdateProfileRequest.link_color:type_name -> twitter1.OptString
+	10,  // 40: twitter1.SendDirectMessageRequest.auth:type_name -> twitter1.Authentication
+	6,   // 41: twitter1.SendDirectMessageRequest.media_id:type_name -> twitter1.OptFixed64
+	10,  // 42: twitter1.ListDirectMessagesRequest.auth:type_name -> twitter1.Authentication
+	10,  // 43: twitter1.ListRequest.auth:type_name -> twitter1.Authentication
+	10,  // 44: twitter1.ListTimelineRequest.auth:type_name -> twitter1.Authentication
+	13,  // 45: twitter1.ListTimelineRequest.timeline_options:type_name -> twitter1.TimelineOptions
+	10,  // 46: twitter1.ListMembersRequest.auth:type_name -> twitter1.Authentication
+	14,  // 47: twitter1.ListMembersRequest.cursor_options:type_name -> twitter1.CursorOptions
+	10,  // 48: twitter1.ListMemberRequest.auth:type_name -> twitter1.Authentication
+	10,  // 49: twitter1.OwnedListsRequest.auth:type_name -> twitter1.Authentication
+	14,  // 50: twitter1.OwnedListsRequest.cursor_options:type_name -> twitter1.CursorOptions
+	10,  // 51: twitter1.UploadMediaRequest.auth:type_name -> twitter1.Authentication
+	76,  // 52: twitter1.MediaChunk.init:type_name -> twitter1.MediaChunk.Init
+	10,  // 53: twitter1.MediaStatusRequest.auth:type_name -> twitter1.Authentication
+	10,  // 54: twitter1.RateLimitsRequest.auth:type_name -> twitter1.Authentication
+	55,  // 55: twitter1.TweetResponse.tweet:type_name -> twitter1.Tweet
+	9,   // 56: twitter1.TweetResponse.error:type_name -> twitter1.Error
+	51,  // 57: twitter1.TweetsResponse.tweets:type_name -> twitter1.Tweets
+	9,   // 58: twitter1.TweetsResponse.error:type_name -> twitter1.Error
+	58,  // 59: twitter1.UserResponse.user:type_name -> twitter1.User
+	9,   // 60: twitter1.UserResponse.error:type_name -> twitter1.Error
+	56,  // 61: twitter1.SearchResponse.result:type_name -> twitter1.SearchResult
+	9,   // 62: twitter1.SearchResponse.error:type_name -> twitter1.Error
+	52,  // 63: twitter1.UsersResponse.users:type_name -> twitter1.Users
+	9,   // 64: twitter1.UsersResponse.error:type_name -> twitter1.Error
+	53,  // 65: twitter1.UserIdsResponse.page:type_name -> twitter1.UserIdPage
+	9,   // 66: twitter1.UserIdsResponse.error:type_name -> twitter1.Error
+	54,  // 67: twitter1.UserPageResponse.page:type_name -> twitter1.UserPage
+	9,   // 68: twitter1.UserPageResponse.error:type_name -> twitter1.Error
+	59,  // 69: twitter1.RelationshipResponse.relationship:type_name -> twitter1.Relationship
+	9,   // 70: twitter1.RelationshipResponse.error:type_name -> twitter1.Error
+	60,  // 71: twitter1.DirectMessageResponse.message:type_name -> twitter1.DirectMessage
+	9,   // 72: twitter1.DirectMessageResponse.error:type_name -> twitter1.Error
+	61,  // 73: twitter1.DirectMessagesResponse.page:type_name -> twitter1.DirectMessagePage
+	9,   // 74: twitter1.DirectMessagesResponse.error:type_name -> twitter1.Error
+	62,  // 75: twitter1.ListResponse.list:type_name -> twitter1.List
+	9,   // 76: twitter1.ListResponse.error:type_name -> twitter1.Error
+	63,  // 77: twitter1.ListPageResponse.page:type_name -> twitter1.ListPage
+	9,   // 78: twitter1.ListPageResponse.error:type_name -> twitter1.Error
+	68,  // 79: twitter1.MediaResponse.media:type_name -> twitter1.UploadedMedia
+	9,   // 80: twitter1.MediaResponse.error:type_name -> twitter1.Error
+	55,  // 81: twitter1.Tweets.tweets:type_name -> twitter1.Tweet
+	58,  // 82: twitter1.Users.users:type_name -> twitter1.User
+	58,  // 83: twitter1.UserPage.users:type_name -> twitter1.User
+	11,  // 84: twitter1.Tweet.text_display_range:type_name -> twitter1.Indices
+	58,  // 85: twitter1.Tweet.user:type_name -> twitter1.User
+	77,  // 86: twitter1.Tweet.replied_tweet:type_name -> twitter1.Tweet.ReplyData
+	55,  // 87: twitter1.Tweet.quoted_tweet:type_name -> twitter1.Tweet
+	55,  // 88: twitter1.Tweet.retweeted_tweet:type_name -> twitter1.Tweet
+	6,   // 89: twitter1.Tweet.current_user_retweet_id:type_name -> twitter1.OptFixed64
+	65,  // 90: twitter1.Tweet.hashtags:type_name -> twitter1.Symbol
+	64,  // 91: twitter1.Tweet.urls:type_name -> twitter1.URL
+	66,  // 92: twitter1.Tweet.mentions:type_name -> twitter1.Mention
+	65,  // 93: twitter1.Tweet.symbols:type_name -> twitter1.Symbol
+	67,  // 94: twitter1.Tweet.media:type_name -> twitter1.Media
+	69,  // 95: twitter1.Tweet.polls:type_name -> twitter1.Poll
+	55,  // 96: twitter1.SearchResult.tweets:type_name -> twitter1.Tweet
+	57,  // 97: twitter1.SearchResult.metadata:type_name -> twitter1.SearchMetadata
+	6,   // 98: twitter1.SearchMetadata.next_max_id:type_name -> twitter1.OptFixed64
+	64,  // 99: twitter1.User.url_urls:type_name -> twitter1.URL
+	64,  // 100: twitter1.User.bio_urls:type_name -> twitter1.URL
+	58,  // 101: twitter1.Relationship.user:type_name -> twitter1.User
+	8,   // 102: twitter1.Relationship.blocking:type_name -> twitter1.OptBool
+	8,   // 103: twitter1.Relationship.muting:type_name -> twitter1.OptBool
+	65,  // 104: twitter1.DirectMessage.hashtags:type_name -> twitter1.Symbol
+	64,  // 105: twitter1.DirectMessage.urls:type_name -> twitter1.URL
+	66,  // 106: twitter1.DirectMessage.mentions:type_name -> twitter1.Mention
+	65,  // 107: twitter1.DirectMessage.symbols:type_name -> twitter1.Symbol
+	67,  // 108: twitter1.DirectMessage.media:type_name -> twitter1.Media
+	60,  // 109: twitter1.DirectMessagePage.messages:type_name -> twitter1.DirectMessage
+	58,  // 110: twitter1.List.owner:type_name -> twitter1.User
+	62,  // 111: twitter1.ListPage.lists:type_name -> twitter1.List
+	11,  // 112: twitter1.URL.indices:type_name -> twitter1.Indices
+	11,  // 113: twitter1.Symbol.indices:type_name -> twitter1.Indices
+	11,  // 114: twitter1.Mention.indices:type_name -> twitter1.Indices
+	64,  // 115: twitter1.Media.url:type_name -> twitter1.URL
+	6,   // 116: twitter1.Media.source_tweet_id:type_name -> twitter1.OptFixed64
+	78,  // 117: twitter1.Media.thumb:type_name -> twitter1.Media.Size
+	78,  // 118: twitter1.Media.small:type_name -> twitter1.Media.Size
+	78,  // 119: twitter1.Media.medium:type_name -> twitter1.Media.Size
+	78,  // 120: twitter1.Media.large:type_name -> twitter1.Media.Size
+	79,  // 121: twitter1.UploadedMedia.processing:type_name -> twitter1.UploadedMedia.Processing
+	80,  // 122: twitter1.Poll.options:type_name -> twitter1.Poll.Option
+	10,  // 123: twitter1.RawAPIRequest.auth:type_name -> twitter1.Authentication
+	81,  // 124: twitter1.RawAPIRequest.query_params:type_name -> twitter1.RawAPIRequest.QueryParamsEntry
+	82,  // 125: twitter1.RawAPIRequest.body_params:type_name -> twitter1.RawAPIRequest.BodyParamsEntry
+	73,  // 126: twitter1.RawAPIResponse.result:type_name -> twitter1.RawAPIResult
+	9,   // 127: twitter1.RawAPIResponse.error:type_name -> twitter1.Error
+	74,  // 128: twitter1.RateLimitsResponse.limits:type_name -> twitter1.RateLimits
+	9,   // 129: twitter1.RateLimitsResponse.error:type_name -> twitter1.Error
+	83,  // 130: twitter1.RawAPIResult.headers:type_name -> twitter1.RawAPIResult.HeadersEntry
+	75,  // 131: twitter1.RateLimits.limits:type_name -> twitter1.RateLimit
+	5,   // 132: twitter1.RateLimit.current:type_name -> twitter1.OptUint64
+	5,   // 133: twitter1.RateLimit.next:type_name -> twitter1.OptUint64
+	10,  // 134: twitter1.MediaChunk.Init.auth:type_name -> twitter1.Authentication
+	3,   // 135: twitter1.UploadedMedia.Processing.state:type_name -> twitter1.UploadedMedia.Processing.State
+	15,  // 136: twitter1.Twitter.GetTweet:input_type -> twitter1.TweetRequest
+	16,  // 137: twitter1.Twitter.GetTweets:input_type -> twitter1.TweetsRequest
+	17,  // 138: twitter1.Twitter.SearchTweets:input_type -> twitter1.SearchRequest
+	15,  // 139: twitter1.Twitter.LikeTweet:input_type -> twitter1.TweetRequest
+	15,  // 140: twitter1.Twitter.UnlikeTweet:input_type -> twitter1.TweetRequest
+	15,  // 141: twitter1.Twitter.RetweetTweet:input_type -> twitter1.TweetRequest
+	15,  // 142: twitter1.Twitter.UnretweetTweet:input_type -> twitter1.TweetRequest
+	15,  // 143: twitter1.Twitter.DeleteTweet:input_type -> twitter1.TweetRequest
+	18,  // 144: twitter1.Twitter.GetHomeTimeline:input_type -> twitter1.HomeTimelineRequest
+	19,  // 145: twitter1.Twitter.GetMentionTimeline:input_type -> twitter1.MentionTimelineRequest
+	20,  // 146: twitter1.Twitter.GetUserTimeline:input_type -> twitter1.UserTimelineRequest
+	21,  // 147: twitter1.Twitter.PublishTweet:input_type -> twitter1.PublishTweetRequest
+	26,  // 148: twitter1.Twitter.UpdateProfile:input_type -> twitter1.UpdateProfileRequest
+	23,  // 149: twitter1.Twitter.GetUser:input_type -> twitter1.UserRequest
+	25,  // 150: twitter1.Twitter.GetUsers:input_type -> twitter1.UsersRequest
+	24,  // 151: twitter1.Twitter.GetFollowerIds:input_type -> twitter1.UserGraphRequest
+	24,  // 152: twitter1.Twitter.GetFollowingIds:input_type -> twitter1.UserGraphRequest
+	24,  // 153: twitter1.Twitter.GetFollowers:input_type -> twitter1.UserGraphRequest
+	24,  // 154: twitter1.Twitter.GetFollowing:input_type -> twitter1.UserGraphRequest
+	24,  // 155: twitter1.Twitter.StreamFollowerIds:input_type -> twitter1.UserGraphRequest
+	24,  // 156: twitter1.Twitter.StreamFollowingIds:input_type -> twitter1.UserGraphRequest
+	24,  // 157: twitter1.Twitter.StreamFollowers:input_type -> twitter1.UserGraphRequest
+	24,  // 158: twitter1.Twitter.StreamFollowing:input_type -> twitter1.UserGraphRequest
+	23,  // 159: twitter1.Twitter.FollowUser:input_type -> twitter1.UserRequest
+	23,  // 160: twitter1.Twitter.UnfollowUser:input_type -> twitter1.UserRequest
+	23,  // 161: twitter1.Twitter.BlockUser:input_type -> twitter1.UserRequest
+	23,  // 162: twitter1.Twitter.UnblockUser:input_type -> twitter1.UserRequest
+	23,  // 163: twitter1.Twitter.MuteUser:input_type -> twitter1.UserRequest
+	23,  // 164: twitter1.Twitter.UnmuteUser:input_type -> twitter1.UserRequest
+	23,  // 165: twitter1.Twitter.GetRelationship:input_type -> twitter1.UserRequest
+	27,  // 166: twitter1.Twitter.SendDirectMessage:input_type -> twitter1.SendDirectMessageRequest
+	28,  // 167: twitter1.Twitter.ListDirectMessages:input_type -> twitter1.ListDirectMessagesRequest
+	29,  // 168: twitter1.Twitter.GetList:input_type -> twitter1.ListRequest
+	30,  // 169: twitter1.Twitter.GetListTimeline:input_type -> twitter1.ListTimelineRequest
+	31,  // 170: twitter1.Twitter.GetListMembers:input_type -> twitter1.ListMembersRequest
+	32,  // 171: twitter1.Twitter.AddListMember:input_type -> twitter1.ListMemberRequest
+	32,  // 172: twitter1.Twitter.RemoveListMember:input_type -> twitter1.ListMemberRequest
+	33,  // 173: twitter1.Twitter.GetOwnedLists:input_type -> twitter1.OwnedListsRequest
+	70,  // 174: twitter1.Twitter.GetRaw:input_type -> twitter1.RawAPIRequest
+	37,  // 175: twitter1.Twitter.GetRateLimits:input_type -> twitter1.RateLimitsRequest
+	22,  // 176: twitter1.Twitter.StreamTweets:input_type -> twitter1.StreamTweetsRequest
+	34,  // 177: twitter1.Twitter.UploadMedia:input_type -> twitter1.UploadMediaRequest
+	35,  // 178: twitter1.Twitter.UploadMediaChunked:input_type -> twitter1.MediaChunk
+	36,  // 179: twitter1.Twitter.GetMediaStatus:input_type -> twitter1.MediaStatusRequest
+	38,  // 180: twitter1.Twitter.GetTweet:output_type -> twitter1.TweetResponse
+	39,  // 181: twitter1.Twitter.GetTweets:output_type -> twitter1.TweetsResponse
+	41,  // 182: twitter1.Twitter.SearchTweets:output_type -> twitter1.SearchResponse
+	38,  // 183: twitter1.Twitter.LikeTweet:output_type -> twitter1.TweetResponse
+	38,  // 184: twitter1.Twitter.UnlikeTweet:output_type -> twitter1.TweetResponse
+	38,  // 185: twitter1.Twitter.RetweetTweet:output_type -> twitter1.TweetResponse
+	38,  // 186: twitter1.Twitter.UnretweetTweet:output_type -> twitter1.TweetResponse
+	38,  // 187: twitter1.Twitter.DeleteTweet:output_type -> twitter1.TweetResponse
+	39,  // 188: twitter1.Twitter.GetHomeTimeline:output_type -> twitter1.TweetsResponse
+	39,  // 189: twitter1.Twitter.GetMentionTimeline:output_type -> twitter1.TweetsResponse
+	39,  // 190: twitter1.Twitter.GetUserTimeline:output_type -> twitter1.TweetsResponse
+	38,  // 191: twitter1.Twitter.PublishTweet:output_type -> twitter1.TweetResponse
+	40,  // 192: twitter1.Twitter.UpdateProfile:output_type -> twitter1.UserResponse
+	40,  // 193: twitter1.Twitter.GetUser:output_type -> twitter1.UserResponse
+	42,  // 194: twitter1.Twitter.GetUsers:output_type -> twitter1.UsersResponse
+	43,  // 195: twitter1.Twitter.GetFollowerIds:output_type -> twitter1.UserIdsResponse
+	43,  // 196: twitter1.Twitter.GetFollowingIds:output_type -> twitter1.UserIdsResponse
+	44,  // 197: twitter1.Twitter.GetFollowers:output_type -> twitter1.UserPageResponse
+	44,  // 198: twitter1.Twitter.GetFollowing:output_type -> twitter1.UserPageResponse
+	43,  // 199: twitter1.Twitter.StreamFollowerIds:output_type -> twitter1.UserIdsResponse
+	43,  // 200: twitter1.Twitter.StreamFollowingIds:output_type -> twitter1.UserIdsResponse
+	44,  // 201: twitter1.Twitter.StreamFollowers:output_type -> twitter1.UserPageResponse
+	44,  // 202: twitter1.Twitter.StreamFollowing:output_type -> twitter1.UserPageResponse
+	45,  // 203: twitter1.Twitter.FollowUser:output_type -> twitter1.RelationshipResponse
+	45,  // 204: twitter1.Twitter.UnfollowUser:output_type -> twitter1.RelationshipResponse
+	45,  // 205: twitter1.Twitter.BlockUser:output_type -> twitter1.RelationshipResponse
+	45,  // 206: twitter1.Twitter.UnblockUser:output_type -> twitter1.RelationshipResponse
+	45,  // 207: twitter1.Twitter.MuteUser:output_type -> twitter1.RelationshipResponse
+	45,  // 208: twitter1.Twitter.UnmuteUser:output_type -> twitter1.RelationshipResponse
+	45,  // 209: twitter1.Twitter.GetRelationship:output_type -> twitter1.RelationshipResponse
+	46,  // 210: twitter1.Twitter.SendDirectMessage:output_type -> twitter1.DirectMessageResponse
+	47,  // 211: twitter1.Twitter.ListDirectMessages:output_type -> twitter1.DirectMessagesResponse
+	48,  // 212: twitter1.Twitter.GetList:output_type -> twitter1.ListResponse
+	39,  // 213: twitter1.Twitter.GetListTimeline:output_type -> twitter1.TweetsResponse
+	44,  // 214: twitter1.Twitter.GetListMembers:output_type -> twitter1.UserPageResponse
+	48,  // 215: twitter1.Twitter.AddListMember:output_type -> twitter1.ListResponse
+	48,  // 216: twitter1.Twitter.RemoveListMember:output_type -> twitter1.ListResponse
+	49,  // 217: twitter1.Twitter.GetOwnedLists:output_type -> twitter1.ListPageResponse
+	71,  // 218: twitter1.Twitter.GetRaw:output_type -> twitter1.RawAPIResponse
+	72,  // 219: twitter1.Twitter.GetRateLimits:output_type -> twitter1.RateLimitsResponse
+	38,  // 220: twitter1.Twitter.StreamTweets:output_type -> twitter1.TweetResponse
+	50,  // 221: twitter1.Twitter.UploadMedia:output_type -> twitter1.MediaResponse
+	50,  // 222: twitter1.Twitter.UploadMediaChunked:output_type -> twitter1.MediaResponse
+	50,  // 223: twitter1.Twitter.GetMediaStatus:output_type -> twitter1.MediaResponse
+	180, // [180:224] is the sub-list for method output_type
+	136, // [136:180] is the sub-list for method input_type
+	136, // [136:136] is the sub-list for extension type_name
+	136, // [136:136] is the sub-list for extension extendee
+	0,   // [0:136] is the sub-list for field type_name
+}
+
+func init() { file_twitter1_proto_init() }
+func file_twitter1_proto_init() {
+	if File_twitter1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_twitter1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptInt64); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptUint64); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptFixed64); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptString); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptBool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Indices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TweetOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelineOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CursorOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TweetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HomeTimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MentionTimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserTimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTweetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTweetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendDirectMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDirectMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OwnedListsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadMediaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TweetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TweetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tweets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Users); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tweet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relationship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectMessagePage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*URL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Symbol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Media); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadedMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Poll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawAPIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawAPIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawAPIResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaChunk_Init); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tweet_ReplyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Media_Size); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadedMedia_Processing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_twitter1_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Poll_Option); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_twitter1_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*UserTimelineRequest_UserId)(nil),
+		(*UserTimelineRequest_UserHandle)(nil),
+	}
+	file_twitter1_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*StreamTweetsRequest_Search)(nil),
+		(*StreamTweetsRequest_MentionTimeline)(nil),
+		(*StreamTweetsRequest_UserTimeline)(nil),
+	}
+	file_twitter1_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*UserRequest_UserId)(nil),
+		(*UserRequest_UserHandle)(nil),
+	}
+	file_twitter1_proto_msgTypes[20].OneofWrappers = []interface{}{
+		(*UserGraphRequest_UserId)(nil),
+		(*UserGraphRequest_UserHandle)(nil),
+	}
+	file_twitter1_proto_msgTypes[28].OneofWrappers = []interface{}{
+		(*ListMemberRequest_UserId)(nil),
+		(*ListMemberRequest_UserHandle)(nil),
+	}
+	file_twitter1_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*OwnedListsRequest_UserId)(nil),
+		(*OwnedListsRequest_UserHandle)(nil),
+	}
+	file_twitter1_proto_msgTypes[31].OneofWrappers = []interface{}{
+		(*MediaChunk_Init_)(nil),
+		(*MediaChunk_Data)(nil),
+	}
+	file_twitter1_proto_msgTypes[34].OneofWrappers = []interface{}{
+		(*TweetResponse_Tweet)(nil),
+		(*TweetResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*TweetsResponse_Tweets)(nil),
+		(*TweetsResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[36].OneofWrappers = []interface{}{
+		(*UserResponse_User)(nil),
+		(*UserResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*SearchResponse_Result)(nil),
+		(*SearchResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[38].OneofWrappers = []interface{}{
+		(*UsersResponse_Users)(nil),
+		(*UsersResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*UserIdsResponse_Page)(nil),
+		(*UserIdsResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[40].OneofWrappers = []interface{}{
+		(*UserPageResponse_Page)(nil),
+		(*UserPageResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*RelationshipResponse_Relationship)(nil),
+		(*RelationshipResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[42].OneofWrappers = []interface{}{
+		(*DirectMessageResponse_Message)(nil),
+		(*DirectMessageResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[43].OneofWrappers = []interface{}{
+		(*DirectMessagesResponse_Page)(nil),
+		(*DirectMessagesResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[44].OneofWrappers = []interface{}{
+		(*ListResponse_List)(nil),
+		(*ListResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*ListPageResponse_Page)(nil),
+		(*ListPageResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[46].OneofWrappers = []interface{}{
+		(*MediaResponse_Media)(nil),
+		(*MediaResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[67].OneofWrappers = []interface{}{
+		(*RawAPIResponse_Result)(nil),
+		(*RawAPIResponse_Error)(nil),
+	}
+	file_twitter1_proto_msgTypes[68].OneofWrappers = []interface{}{
+		(*RateLimitsResponse_Limits)(nil),
+		(*RateLimitsResponse_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_twitter1_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   80,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_twitter1_proto_goTypes,
+		DependencyIndexes: file_twitter1_proto_depIdxs,
+		EnumInfos:         file_twitter1_proto_enumTypes,
+		MessageInfos:      file_twitter1_proto_msgTypes,
+	}.Build()
+	File_twitter1_proto = out.File
+	file_twitter1_proto_rawDesc = nil
+	file_twitter1_proto_goTypes = nil
+	file_twitter1_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// TwitterClient is the client API for Twitter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TwitterClient interface {
+	GetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
+	GetTweets(ctx context.Context, in *TweetsRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
+	SearchTweets(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	LikeTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
+	UnlikeTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
+	RetweetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
+	UnretweetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
+	DeleteTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
+	GetHomeTimeline(ctx context.Context, in *HomeTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
+	GetMentionTimeline(ctx context.Context, in *MentionTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
+	GetUserTimeline(ctx context.Context, in *UserTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
+	PublishTweet(ctx context.Context, in *PublishTweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	GetUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	GetUsers(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*UsersResponse, error)
+	GetFollowerIds(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (*UserIdsResponse, error)
+	GetFollowingIds(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (*UserIdsResponse, error)
+	GetFollowers(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (*UserPageResponse, error)
+	GetFollowing(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (*UserPageResponse, error)
+	StreamFollowerIds(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (Twitter_StreamFollowerIdsClient, error)
+	StreamFollowingIds(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (Twitter_StreamFollowingIdsClient, error)
+	StreamFollowers(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (Twitter_StreamFollowersClient, error)
+	StreamFollowing(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (Twitter_StreamFollowingClient, error)
+	FollowUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error)
+	UnfollowUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error)
+	BlockUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error)
+	UnblockUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error)
+	MuteUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error)
+	UnmuteUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error)
+	GetRelationship(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error)
+	SendDirectMessage(ctx context.Context, in *SendDirectMessageRequest, opts ...grpc.CallOption) (*DirectMessageResponse, error)
+	ListDirectMessages(ctx context.Context, in *ListDirectMessagesRequest, opts ...grpc.CallOption) (*DirectMessagesResponse, error)
+	GetList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	GetListTimeline(ctx context.Context, in *ListTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
+	GetListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*UserPageResponse, error)
+	AddListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	RemoveListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	GetOwnedLists(ctx context.Context, in *OwnedListsRequest, opts ...grpc.CallOption) (*ListPageResponse, error)
+	GetRaw(ctx context.Context, in *RawAPIRequest, opts ...grpc.CallOption) (*RawAPIResponse, error)
+	GetRateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
+	StreamTweets(ctx context.Context, in *StreamTweetsRequest, opts ...grpc.CallOption) (Twitter_StreamTweetsClient, error)
+	UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*MediaResponse, error)
+	UploadMediaChunked(ctx context.Context, opts ...grpc.CallOption) (Twitter_UploadMediaChunkedClient, error)
+	GetMediaStatus(ctx context.Context, in *MediaStatusRequest, opts ...grpc.CallOption) (*MediaResponse, error)
+}
+
+type twitterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTwitterClient(cc grpc.ClientConnInterface) TwitterClient {
+	return &twitterClient{cc}
+}
+
+func (c *twitterClient) GetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
+	out := new(TweetResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetTweets(ctx context.Context, in *TweetsRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
+	out := new(TweetsResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetTweets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) SearchTweets(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/SearchTweets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) LikeTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
+	out := new(TweetResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/LikeTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) UnlikeTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
+	out := new(TweetResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UnlikeTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) RetweetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
+	out := new(TweetResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/RetweetTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) UnretweetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
+	out := new(TweetResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UnretweetTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) DeleteTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
+	out := new(TweetResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/DeleteTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetHomeTimeline(ctx context.Context, in *HomeTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
+	out := new(TweetsResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetHomeTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetMentionTimeline(ctx context.Context, in *MentionTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
+	out := new(TweetsResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetMentionTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetUserTimeline(ctx context.Context, in *UserTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
+	out := new(TweetsResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetUserTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) PublishTweet(ctx context.Context, in *PublishTweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
+	out := new(TweetResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/PublishTweet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetUsers(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetFollowerIds(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (*UserIdsResponse, error) {
+	out := new(UserIdsResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetFollowerIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetFollowingIds(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (*UserIdsResponse, error) {
+	out := new(UserIdsResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetFollowingIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetFollowers(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (*UserPageResponse, error) {
+	out := new(UserPageResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetFollowing(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (*UserPageResponse, error) {
+	out := new(UserPageResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetFollowing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) StreamFollowerIds(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (Twitter_StreamFollowerIdsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Twitter_serviceDesc.Streams[0], "/twitter1.Twitter/StreamFollowerIds", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &twitterStreamFollowerIdsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Twitter_StreamFollowerIdsClient interface {
+	Recv() (*UserIdsResponse, error)
+	grpc.ClientStream
+}
+
+type twitterStreamFollowerIdsClient struct {
+	grpc.ClientStream
+}
+
+func (x *twitterStreamFollowerIdsClient) Recv() (*UserIdsResponse, error) {
+	m := new(UserIdsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *twitterClient) StreamFollowingIds(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (Twitter_StreamFollowingIdsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Twitter_serviceDesc.Streams[1], "/twitter1.Twitter/StreamFollowingIds", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &twitterStreamFollowingIdsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Twitter_StreamFollowingIdsClient interface {
+	Recv() (*UserIdsResponse, error)
+	grpc.ClientStream
+}
+
+type twitterStreamFollowingIdsClient struct {
+	grpc.ClientStream
+}
+
+func (x *twitterStreamFollowingIdsClient) Recv() (*UserIdsResponse, error) {
+	m := new(UserIdsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *twitterClient) StreamFollowers(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (Twitter_StreamFollowersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Twitter_serviceDesc.Streams[2], "/twitter1.Twitter/StreamFollowers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &twitterStreamFollowersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Twitter_StreamFollowersClient interface {
+	Recv() (*UserPageResponse, error)
+	grpc.ClientStream
+}
+
+type twitterStreamFollowersClient struct {
+	grpc.ClientStream
+}
+
+func (x *twitterStreamFollowersClient) Recv() (*UserPageResponse, error) {
+	m := new(UserPageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *twitterClient) StreamFollowing(ctx context.Context, in *UserGraphRequest, opts ...grpc.CallOption) (Twitter_StreamFollowingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Twitter_serviceDesc.Streams[3], "/twitter1.Twitter/StreamFollowing", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &twitterStreamFollowingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Twitter_StreamFollowingClient interface {
+	Recv() (*UserPageResponse, error)
+	grpc.ClientStream
+}
+
+type twitterStreamFollowingClient struct {
+	grpc.ClientStream
+}
+
+func (x *twitterStreamFollowingClient) Recv() (*UserPageResponse, error) {
+	m := new(UserPageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *twitterClient) FollowUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error) {
+	out := new(RelationshipResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/FollowUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) UnfollowUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error) {
+	out := new(RelationshipResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UnfollowUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) BlockUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error) {
+	out := new(RelationshipResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/BlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) UnblockUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error) {
+	out := new(RelationshipResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UnblockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) MuteUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error) {
+	out := new(RelationshipResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/MuteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) UnmuteUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error) {
+	out := new(RelationshipResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UnmuteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetRelationship(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*RelationshipResponse, error) {
+	out := new(RelationshipResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) SendDirectMessage(ctx context.Context, in *SendDirectMessageRequest, opts ...grpc.CallOption) (*DirectMessageResponse, error) {
+	out := new(DirectMessageResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/SendDirectMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) ListDirectMessages(ctx context.Context, in *ListDirectMessagesRequest, opts ...grpc.CallOption) (*DirectMessagesResponse, error) {
+	out := new(DirectMessagesResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/ListDirectMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetListTimeline(ctx context.Context, in *ListTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
+	out := new(TweetsResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetListTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*UserPageResponse, error) {
+	out := new(UserPageResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetListMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) AddListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/AddListMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) RemoveListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/RemoveListMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetOwnedLists(ctx context.Context, in *OwnedListsRequest, opts ...grpc.CallOption) (*ListPageResponse, error) {
+	out := new(ListPageResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetOwnedLists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetRaw(ctx context.Context, in *RawAPIRequest, opts ...grpc.CallOption) (*RawAPIResponse, error) {
+	out := new(RawAPIResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetRaw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) GetRateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterClient) StreamTweets(ctx context.Context, in *StreamTweetsRequest, opts ...grpc.CallOption) (Twitter_StreamTweetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Twitter_serviceDesc.Streams[4], "/twitter1.Twitter/StreamTweets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &twitterStreamTweetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	file_twitter1_proto_msgTypes[14].OneofWrappers = []interface{}{
-		(*UserTimelineRequest_UserId)(nil),
-		(*UserTimelineRequest_UserHandle)(nil),
+	return x, nil
+}
+
+type Twitter_StreamTweetsClient interface {
+	Recv() (*TweetResponse, error)
+	grpc.ClientStream
+}
+
+type twitterStreamTweetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *twitterStreamTweetsClient) Recv() (*TweetResponse, error) {
+	m := new(TweetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	file_twitter1_proto_msgTypes[17].OneofWrappers = []interface{}{
-		(*TweetResponse_Tweet)(nil),
-		(*TweetResponse_Error)(nil),
+	return m, nil
+}
+
+func (c *twitterClient) UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*MediaResponse, error) {
+	out := new(MediaResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UploadMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_twitter1_proto_msgTypes[18].OneofWrappers = []interface{}{
-		(*TweetsResponse_Tweets)(nil),
-		(*TweetsResponse_Error)(nil),
+	return out, nil
+}
+
+func (c *twitterClient) UploadMediaChunked(ctx context.Context, opts ...grpc.CallOption) (Twitter_UploadMediaChunkedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Twitter_serviceDesc.Streams[5], "/twitter1.Twitter/UploadMediaChunked", opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_twitter1_proto_msgTypes[19].OneofWrappers = []interface{}{
-		(*UserResponse_User)(nil),
-		(*UserResponse_Error)(nil),
+	x := &twitterUploadMediaChunkedClient{stream}
+	return x, nil
+}
+
+type Twitter_UploadMediaChunkedClient interface {
+	Send(*MediaChunk) error
+	CloseAndRecv() (*MediaResponse, error)
+	grpc.ClientStream
+}
+
+type twitterUploadMediaChunkedClient struct {
+	grpc.ClientStream
+}
+
+func (x *twitterUploadMediaChunkedClient) Send(m *MediaChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *twitterUploadMediaChunkedClient) CloseAndRecv() (*MediaResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_twitter1_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   36,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_twitter1_proto_goTypes,
-		DependencyIndexes: file_twitter1_proto_depIdxs,
-		EnumInfos:         file_twitter1_proto_enumTypes,
-		MessageInfos:      file_twitter1_proto_msgTypes,
-	}.Build()
-	File_twitter1_proto = out.File
-	file_twitter1_proto_rawDesc = nil
-	file_twitter1_proto_goTypes = nil
-	file_twitter1_proto_depIdxs = nil
+	m := new(MediaResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (c *twitterClient) GetMediaStatus(ctx context.Context, in *MediaStatusRequest, opts ...grpc.CallOption) (*MediaResponse, error) {
+	out := new(MediaResponse)
+	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetMediaStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+// TwitterServer is the server API for Twitter service.
+type TwitterServer interface {
+	GetTweet(context.Context, *TweetRequest) (*TweetResponse, error)
+	GetTweets(context.Context, *TweetsRequest) (*TweetsResponse, error)
+	SearchTweets(context.Context, *SearchRequest) (*SearchResponse, error)
+	LikeTweet(context.Context, *TweetRequest) (*TweetResponse, error)
+	UnlikeTweet(context.Context, *TweetRequest) (*TweetResponse, error)
+	RetweetTweet(context.Context, *TweetRequest) (*TweetResponse, error)
+	UnretweetTweet(context.Context, *TweetRequest) (*TweetResponse, error)
+	DeleteTweet(context.Context, *TweetRequest) (*TweetResponse, error)
+	GetHomeTimeline(context.Context, *HomeTimelineRequest) (*TweetsResponse, error)
+	GetMentionTimeline(context.Context, *MentionTimelineRequest) (*TweetsResponse, error)
+	GetUserTimeline(context.Context, *UserTimelineRequest) (*TweetsResponse, error)
+	PublishTweet(context.Context, *PublishTweetRequest) (*TweetResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UserResponse, error)
+	GetUser(context.Context, *UserRequest) (*UserResponse, error)
+	GetUsers(context.Context, *UsersRequest) (*UsersResponse, error)
+	GetFollowerIds(context.Context, *UserGraphRequest) (*UserIdsResponse, error)
+	GetFollowingIds(context.Context, *UserGraphRequest) (*UserIdsResponse, error)
+	GetFollowers(context.Context, *UserGraphRequest) (*UserPageResponse, error)
+	GetFollowing(context.Context, *UserGraphRequest) (*UserPageResponse, error)
+	StreamFollowerIds(*UserGraphRequest, Twitter_StreamFollowerIdsServer) error
+	StreamFollowingIds(*UserGraphRequest, Twitter_StreamFollowingIdsServer) error
+	StreamFollowers(*UserGraphRequest, Twitter_StreamFollowersServer) error
+	StreamFollowing(*UserGraphRequest, Twitter_StreamFollowingServer) error
+	FollowUser(context.Context, *UserRequest) (*RelationshipResponse, error)
+	UnfollowUser(context.Context, *UserRequest) (*RelationshipResponse, error)
+	BlockUser(context.Context, *UserRequest) (*RelationshipResponse, error)
+	UnblockUser(context.Context, *UserRequest) (*RelationshipResponse, error)
+	MuteUser(context.Context, *UserRequest) (*RelationshipResponse, error)
+	UnmuteUser(context.Context, *UserRequest) (*RelationshipResponse, error)
+	GetRelationship(context.Context, *UserRequest) (*RelationshipResponse, error)
+	SendDirectMessage(context.Context, *SendDirectMessageRequest) (*DirectMessageResponse, error)
+	ListDirectMessages(context.Context, *ListDirectMessagesRequest) (*DirectMessagesResponse, error)
+	GetList(context.Context, *ListRequest) (*ListResponse, error)
+	GetListTimeline(context.Context, *ListTimelineRequest) (*TweetsResponse, error)
+	GetListMembers(context.Context, *ListMembersRequest) (*UserPageResponse, error)
+	AddListMember(context.Context, *ListMemberRequest) (*ListResponse, error)
+	RemoveListMember(context.Context, *ListMemberRequest) (*ListResponse, error)
+	GetOwnedLists(context.Context, *OwnedListsRequest) (*ListPageResponse, error)
+	GetRaw(context.Context, *RawAPIRequest) (*RawAPIResponse, error)
+	GetRateLimits(context.Context, *RateLimitsRequest) (*RateLimitsResponse, error)
+	StreamTweets(*StreamTweetsRequest, Twitter_StreamTweetsServer) error
+	UploadMedia(context.Context, *UploadMediaRequest) (*MediaResponse, error)
+	UploadMediaChunked(Twitter_UploadMediaChunkedServer) error
+	GetMediaStatus(context.Context, *MediaStatusRequest) (*MediaResponse, error)
+}
 
-// TwitterClient is the client API for Twitter service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TwitterClient interface {
-	GetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
-	GetTweets(ctx context.Context, in *TweetsRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
-	SearchTweets(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
-	LikeTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
-	UnlikeTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
-	RetweetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
-	UnretweetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
-	DeleteTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
-	GetHomeTimeline(ctx context.Context, in *HomeTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
-	GetMentionTimeline(ctx context.Context, in *MentionTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
-	GetUserTimeline(ctx context.Context, in *UserTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error)
-	PublishTweet(ctx context.Context, in *PublishTweetRequest, opts ...grpc.CallOption) (*TweetResponse, error)
-	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserResponse, error)
-	GetRaw(ctx context.Context, in *RawAPIRequest, opts ...grpc.CallOption) (*RawAPIResult, error)
+// UnimplementedTwitterServer can be embedded to have forward compatible implementations.
+type UnimplementedTwitterServer struct {
 }
 
-type twitterClient struct {
-	cc grpc.ClientConnInterface
+func (*UnimplementedTwitterServer) GetTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTweet not implemented")
+}
+func (*UnimplementedTwitterServer) GetTweets(context.Context, *TweetsRequest) (*TweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTweets not implemented")
+}
+func (*UnimplementedTwitterServer) SearchTweets(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTweets not implemented")
+}
+func (*UnimplementedTwitterServer) LikeTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeTweet not implemented")
+}
+func (*UnimplementedTwitterServer) UnlikeTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeTweet not implemented")
+}
+func (*UnimplementedTwitterServer) RetweetTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetweetTweet not implemented")
+}
+func (*UnimplementedTwitterServer) UnretweetTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnretweetTweet not implemented")
+}
+func (*UnimplementedTwitterServer) DeleteTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTweet not implemented")
+}
+func (*UnimplementedTwitterServer) GetHomeTimeline(context.Context, *HomeTimelineRequest) (*TweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHomeTimeline not implemented")
+}
+func (*UnimplementedTwitterServer) GetMentionTimeline(context.Context, *MentionTimelineRequest) (*TweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentionTimeline not implemented")
+}
+func (*UnimplementedTwitterServer) GetUserTimeline(context.Context, *UserTimelineRequest) (*TweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTimeline not implemented")
+}
+func (*UnimplementedTwitterServer) PublishTweet(context.Context, *PublishTweetRequest) (*TweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTweet not implemented")
+}
+func (*UnimplementedTwitterServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (*UnimplementedTwitterServer) GetUser(context.Context, *UserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (*UnimplementedTwitterServer) GetUsers(context.Context, *UsersRequest) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+}
+func (*UnimplementedTwitterServer) GetFollowerIds(context.Context, *UserGraphRequest) (*UserIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerIds not implemented")
+}
+func (*UnimplementedTwitterServer) GetFollowingIds(context.Context, *UserGraphRequest) (*UserIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingIds not implemented")
+}
+func (*UnimplementedTwitterServer) GetFollowers(context.Context, *UserGraphRequest) (*UserPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
+}
+func (*UnimplementedTwitterServer) GetFollowing(context.Context, *UserGraphRequest) (*UserPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowing not implemented")
+}
+func (*UnimplementedTwitterServer) StreamFollowerIds(*UserGraphRequest, Twitter_StreamFollowerIdsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFollowerIds not implemented")
+}
+func (*UnimplementedTwitterServer) StreamFollowingIds(*UserGraphRequest, Twitter_StreamFollowingIdsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFollowingIds not implemented")
+}
+func (*UnimplementedTwitterServer) StreamFollowers(*UserGraphRequest, Twitter_StreamFollowersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFollowers not implemented")
+}
+func (*UnimplementedTwitterServer) StreamFollowing(*UserGraphRequest, Twitter_StreamFollowingServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFollowing not implemented")
+}
+func (*UnimplementedTwitterServer) FollowUser(context.Context, *UserRequest) (*RelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (*UnimplementedTwitterServer) UnfollowUser(context.Context, *UserRequest) (*RelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (*UnimplementedTwitterServer) BlockUser(context.Context, *UserRequest) (*RelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (*UnimplementedTwitterServer) UnblockUser(context.Context, *UserRequest) (*RelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (*UnimplementedTwitterServer) MuteUser(context.Context, *UserRequest) (*RelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (*UnimplementedTwitterServer) UnmuteUser(context.Context, *UserRequest) (*RelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (*UnimplementedTwitterServer) GetRelationship(context.Context, *UserRequest) (*RelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+}
+func (*UnimplementedTwitterServer) SendDirectMessage(context.Context, *SendDirectMessageRequest) (*DirectMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDirectMessage not implemented")
+}
+func (*UnimplementedTwitterServer) ListDirectMessages(context.Context, *ListDirectMessagesRequest) (*DirectMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDirectMessages not implemented")
+}
+func (*UnimplementedTwitterServer) GetList(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (*UnimplementedTwitterServer) GetListTimeline(context.Context, *ListTimelineRequest) (*TweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListTimeline not implemented")
+}
+func (*UnimplementedTwitterServer) GetListMembers(context.Context, *ListMembersRequest) (*UserPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListMembers not implemented")
+}
+func (*UnimplementedTwitterServer) AddListMember(context.Context, *ListMemberRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddListMember not implemented")
+}
+func (*UnimplementedTwitterServer) RemoveListMember(context.Context, *ListMemberRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveListMember not implemented")
+}
+func (*UnimplementedTwitterServer) GetOwnedLists(context.Context, *OwnedListsRequest) (*ListPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOwnedLists not implemented")
+}
+func (*UnimplementedTwitterServer) GetRaw(context.Context, *RawAPIRequest) (*RawAPIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRaw not implemented")
+}
+func (*UnimplementedTwitterServer) GetRateLimits(context.Context, *RateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimits not implemented")
+}
+func (*UnimplementedTwitterServer) StreamTweets(*StreamTweetsRequest, Twitter_StreamTweetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTweets not implemented")
+}
+func (*UnimplementedTwitterServer) UploadMedia(context.Context, *UploadMediaRequest) (*MediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (*UnimplementedTwitterServer) UploadMediaChunked(Twitter_UploadMediaChunkedServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMediaChunked not implemented")
+}
+func (*UnimplementedTwitterServer) GetMediaStatus(context.Context, *MediaStatusRequest) (*MediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMediaStatus not implemented")
 }
 
-func NewTwitterClient(cc grpc.ClientConnInterface) TwitterClient {
-	return &twitterClient{cc}
+func RegisterTwitterServer(s *grpc.Server, srv TwitterServer) {
+	s.RegisterService(&_Twitter_serviceDesc, srv)
+}
+
+func _Twitter_GetTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).GetTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetTweet(ctx, req.(*TweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitter_GetTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).GetTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetTweets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetTweets(ctx, req.(*TweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitter_SearchTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).SearchTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/SearchTweets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).SearchTweets(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitter_LikeTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).LikeTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/LikeTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).LikeTweet(ctx, req.(*TweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitter_UnlikeTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).UnlikeTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/UnlikeTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).UnlikeTweet(ctx, req.(*TweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) GetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
-	out := new(TweetResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetTweet", in, out, opts...)
-	if err != nil {
+func _Twitter_RetweetTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TweetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).RetweetTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/RetweetTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).RetweetTweet(ctx, req.(*TweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) GetTweets(ctx context.Context, in *TweetsRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
-	out := new(TweetsResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetTweets", in, out, opts...)
-	if err != nil {
+func _Twitter_UnretweetTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TweetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).UnretweetTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/UnretweetTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).UnretweetTweet(ctx, req.(*TweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) SearchTweets(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
-	out := new(TweetsResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/SearchTweets", in, out, opts...)
-	if err != nil {
+func _Twitter_DeleteTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TweetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).DeleteTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/DeleteTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).DeleteTweet(ctx, req.(*TweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) LikeTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
-	out := new(TweetResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/LikeTweet", in, out, opts...)
-	if err != nil {
+func _Twitter_GetHomeTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HomeTimelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetHomeTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetHomeTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetHomeTimeline(ctx, req.(*HomeTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) UnlikeTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
-	out := new(TweetResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UnlikeTweet", in, out, opts...)
-	if err != nil {
+func _Twitter_GetMentionTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MentionTimelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetMentionTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetMentionTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetMentionTimeline(ctx, req.(*MentionTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) RetweetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
-	out := new(TweetResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/RetweetTweet", in, out, opts...)
-	if err != nil {
+func _Twitter_GetUserTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserTimelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetUserTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetUserTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetUserTimeline(ctx, req.(*UserTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) UnretweetTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
-	out := new(TweetResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UnretweetTweet", in, out, opts...)
-	if err != nil {
+func _Twitter_PublishTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTweetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).PublishTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/PublishTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).PublishTweet(ctx, req.(*PublishTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) DeleteTweet(ctx context.Context, in *TweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
-	out := new(TweetResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/DeleteTweet", in, out, opts...)
-	if err != nil {
+func _Twitter_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) GetHomeTimeline(ctx context.Context, in *HomeTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
-	out := new(TweetsResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetHomeTimeline", in, out, opts...)
-	if err != nil {
+func _Twitter_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) GetMentionTimeline(ctx context.Context, in *MentionTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
-	out := new(TweetsResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetMentionTimeline", in, out, opts...)
-	if err != nil {
+func _Twitter_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetUsers(ctx, req.(*UsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) GetUserTimeline(ctx context.Context, in *UserTimelineRequest, opts ...grpc.CallOption) (*TweetsResponse, error) {
-	out := new(TweetsResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetUserTimeline", in, out, opts...)
-	if err != nil {
+func _Twitter_GetFollowerIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGraphRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetFollowerIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetFollowerIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetFollowerIds(ctx, req.(*UserGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) PublishTweet(ctx context.Context, in *PublishTweetRequest, opts ...grpc.CallOption) (*TweetResponse, error) {
-	out := new(TweetResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/PublishTweet", in, out, opts...)
-	if err != nil {
+func _Twitter_GetFollowingIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGraphRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetFollowingIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetFollowingIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetFollowingIds(ctx, req.(*UserGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/UpdateProfile", in, out, opts...)
-	if err != nil {
+func _Twitter_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGraphRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetFollowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetFollowers(ctx, req.(*UserGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterClient) GetRaw(ctx context.Context, in *RawAPIRequest, opts ...grpc.CallOption) (*RawAPIResult, error) {
-	out := new(RawAPIResult)
-	err := c.cc.Invoke(ctx, "/twitter1.Twitter/GetRaw", in, out, opts...)
-	if err != nil {
+func _Twitter_GetFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGraphRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterServer).GetFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetFollowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetFollowing(ctx, req.(*UserGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// TwitterServer is the server API for Twitter service.
-type TwitterServer interface {
-	GetTweet(context.Context, *TweetRequest) (*TweetResponse, error)
-	GetTweets(context.Context, *TweetsRequest) (*TweetsResponse, error)
-	SearchTweets(context.Context, *SearchRequest) (*TweetsResponse, error)
-	LikeTweet(context.Context, *TweetRequest) (*TweetResponse, error)
-	UnlikeTweet(context.Context, *TweetRequest) (*TweetResponse, error)
-	RetweetTweet(context.Context, *TweetRequest) (*TweetResponse, error)
-	UnretweetTweet(context.Context, *TweetRequest) (*TweetResponse, error)
-	DeleteTweet(context.Context, *TweetRequest) (*TweetResponse, error)
-	GetHomeTimeline(context.Context, *HomeTimelineRequest) (*TweetsResponse, error)
-	GetMentionTimeline(context.Context, *MentionTimelineRequest) (*TweetsResponse, error)
-	GetUserTimeline(context.Context, *UserTimelineRequest) (*TweetsResponse, error)
-	PublishTweet(context.Context, *PublishTweetRequest) (*TweetResponse, error)
-	UpdateProfile(context.Context, *UpdateProfileRequest) (*UserResponse, error)
-	GetRaw(context.Context, *RawAPIRequest) (*RawAPIResult, error)
+func _Twitter_StreamFollowerIds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UserGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TwitterServer).StreamFollowerIds(m, &twitterStreamFollowerIdsServer{stream})
 }
 
-// UnimplementedTwitterServer can be embedded to have forward compatible implementations.
-type UnimplementedTwitterServer struct {
+type Twitter_StreamFollowerIdsServer interface {
+	Send(*UserIdsResponse) error
+	grpc.ServerStream
 }
 
-func (*UnimplementedTwitterServer) GetTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTweet not implemented")
+type twitterStreamFollowerIdsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedTwitterServer) GetTweets(context.Context, *TweetsRequest) (*TweetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTweets not implemented")
+
+func (x *twitterStreamFollowerIdsServer) Send(m *UserIdsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedTwitterServer) SearchTweets(context.Context, *SearchRequest) (*TweetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchTweets not implemented")
+
+func _Twitter_StreamFollowingIds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UserGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TwitterServer).StreamFollowingIds(m, &twitterStreamFollowingIdsServer{stream})
 }
-func (*UnimplementedTwitterServer) LikeTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikeTweet not implemented")
+
+type Twitter_StreamFollowingIdsServer interface {
+	Send(*UserIdsResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedTwitterServer) UnlikeTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnlikeTweet not implemented")
+
+type twitterStreamFollowingIdsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedTwitterServer) RetweetTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetweetTweet not implemented")
+
+func (x *twitterStreamFollowingIdsServer) Send(m *UserIdsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedTwitterServer) UnretweetTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnretweetTweet not implemented")
+
+func _Twitter_StreamFollowers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UserGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TwitterServer).StreamFollowers(m, &twitterStreamFollowersServer{stream})
 }
-func (*UnimplementedTwitterServer) DeleteTweet(context.Context, *TweetRequest) (*TweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTweet not implemented")
+
+type Twitter_StreamFollowersServer interface {
+	Send(*UserPageResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedTwitterServer) GetHomeTimeline(context.Context, *HomeTimelineRequest) (*TweetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHomeTimeline not implemented")
+
+type twitterStreamFollowersServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedTwitterServer) GetMentionTimeline(context.Context, *MentionTimelineRequest) (*TweetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMentionTimeline not implemented")
+
+func (x *twitterStreamFollowersServer) Send(m *UserPageResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedTwitterServer) GetUserTimeline(context.Context, *UserTimelineRequest) (*TweetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserTimeline not implemented")
+
+func _Twitter_StreamFollowing_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UserGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TwitterServer).StreamFollowing(m, &twitterStreamFollowingServer{stream})
 }
-func (*UnimplementedTwitterServer) PublishTweet(context.Context, *PublishTweetRequest) (*TweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishTweet not implemented")
+
+type Twitter_StreamFollowingServer interface {
+	Send(*UserPageResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedTwitterServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+
+type twitterStreamFollowingServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedTwitterServer) GetRaw(context.Context, *RawAPIRequest) (*RawAPIResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRaw not implemented")
+
+func (x *twitterStreamFollowingServer) Send(m *UserPageResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func RegisterTwitterServer(s *grpc.Server, srv TwitterServer) {
-	s.RegisterService(&_Twitter_serviceDesc, srv)
+func _Twitter_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/FollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).FollowUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_GetTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TweetRequest)
+func _Twitter_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).GetTweet(ctx, in)
+		return srv.(TwitterServer).UnfollowUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/GetTweet",
+		FullMethod: "/twitter1.Twitter/UnfollowUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).GetTweet(ctx, req.(*TweetRequest))
+		return srv.(TwitterServer).UnfollowUser(ctx, req.(*UserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_GetTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TweetsRequest)
+func _Twitter_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).GetTweets(ctx, in)
+		return srv.(TwitterServer).BlockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/GetTweets",
+		FullMethod: "/twitter1.Twitter/BlockUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).GetTweets(ctx, req.(*TweetsRequest))
+		return srv.(TwitterServer).BlockUser(ctx, req.(*UserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_SearchTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
+func _Twitter_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).SearchTweets(ctx, in)
+		return srv.(TwitterServer).UnblockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/SearchTweets",
+		FullMethod: "/twitter1.Twitter/UnblockUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).SearchTweets(ctx, req.(*SearchRequest))
+		return srv.(TwitterServer).UnblockUser(ctx, req.(*UserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_LikeTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TweetRequest)
+func _Twitter_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).LikeTweet(ctx, in)
+		return srv.(TwitterServer).MuteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/LikeTweet",
+		FullMethod: "/twitter1.Twitter/MuteUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).LikeTweet(ctx, req.(*TweetRequest))
+		return srv.(TwitterServer).MuteUser(ctx, req.(*UserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_UnlikeTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TweetRequest)
+func _Twitter_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).UnlikeTweet(ctx, in)
+		return srv.(TwitterServer).UnmuteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/UnlikeTweet",
+		FullMethod: "/twitter1.Twitter/UnmuteUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).UnlikeTweet(ctx, req.(*TweetRequest))
+		return srv.(TwitterServer).UnmuteUser(ctx, req.(*UserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_RetweetTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TweetRequest)
+func _Twitter_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).RetweetTweet(ctx, in)
+		return srv.(TwitterServer).GetRelationship(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/RetweetTweet",
+		FullMethod: "/twitter1.Twitter/GetRelationship",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).RetweetTweet(ctx, req.(*TweetRequest))
+		return srv.(TwitterServer).GetRelationship(ctx, req.(*UserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_UnretweetTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TweetRequest)
+func _Twitter_SendDirectMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDirectMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).UnretweetTweet(ctx, in)
+		return srv.(TwitterServer).SendDirectMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/UnretweetTweet",
+		FullMethod: "/twitter1.Twitter/SendDirectMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).UnretweetTweet(ctx, req.(*TweetRequest))
+		return srv.(TwitterServer).SendDirectMessage(ctx, req.(*SendDirectMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_DeleteTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TweetRequest)
+func _Twitter_ListDirectMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).DeleteTweet(ctx, in)
+		return srv.(TwitterServer).ListDirectMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/DeleteTweet",
+		FullMethod: "/twitter1.Twitter/ListDirectMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).DeleteTweet(ctx, req.(*TweetRequest))
+		return srv.(TwitterServer).ListDirectMessages(ctx, req.(*ListDirectMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_GetHomeTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HomeTimelineRequest)
+func _Twitter_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).GetHomeTimeline(ctx, in)
+		return srv.(TwitterServer).GetList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/GetHomeTimeline",
+		FullMethod: "/twitter1.Twitter/GetList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).GetHomeTimeline(ctx, req.(*HomeTimelineRequest))
+		return srv.(TwitterServer).GetList(ctx, req.(*ListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_GetMentionTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MentionTimelineRequest)
+func _Twitter_GetListTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).GetMentionTimeline(ctx, in)
+		return srv.(TwitterServer).GetListTimeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/GetMentionTimeline",
+		FullMethod: "/twitter1.Twitter/GetListTimeline",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).GetMentionTimeline(ctx, req.(*MentionTimelineRequest))
+		return srv.(TwitterServer).GetListTimeline(ctx, req.(*ListTimelineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_GetUserTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserTimelineRequest)
+func _Twitter_GetListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).GetUserTimeline(ctx, in)
+		return srv.(TwitterServer).GetListMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/GetUserTimeline",
+		FullMethod: "/twitter1.Twitter/GetListMembers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).GetUserTimeline(ctx, req.(*UserTimelineRequest))
+		return srv.(TwitterServer).GetListMembers(ctx, req.(*ListMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_PublishTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishTweetRequest)
+func _Twitter_AddListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).PublishTweet(ctx, in)
+		return srv.(TwitterServer).AddListMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/PublishTweet",
+		FullMethod: "/twitter1.Twitter/AddListMember",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).PublishTweet(ctx, req.(*PublishTweetRequest))
+		return srv.(TwitterServer).AddListMember(ctx, req.(*ListMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Twitter_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProfileRequest)
+func _Twitter_RemoveListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterServer).UpdateProfile(ctx, in)
+		return srv.(TwitterServer).RemoveListMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/twitter1.Twitter/UpdateProfile",
+		FullMethod: "/twitter1.Twitter/RemoveListMember",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+		return srv.(TwitterServer).RemoveListMember(ctx, req.(*ListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitter_GetOwnedLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnedListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).GetOwnedLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetOwnedLists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetOwnedLists(ctx, req.(*OwnedListsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4494,6 +10411,107 @@ func _Twitter_GetRaw_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Twitter_GetRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).GetRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetRateLimits(ctx, req.(*RateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitter_StreamTweets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTweetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TwitterServer).StreamTweets(m, &twitterStreamTweetsServer{stream})
+}
+
+type Twitter_StreamTweetsServer interface {
+	Send(*TweetResponse) error
+	grpc.ServerStream
+}
+
+type twitterStreamTweetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *twitterStreamTweetsServer) Send(m *TweetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Twitter_UploadMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).UploadMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/UploadMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).UploadMedia(ctx, req.(*UploadMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Twitter_UploadMediaChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TwitterServer).UploadMediaChunked(&twitterUploadMediaChunkedServer{stream})
+}
+
+type Twitter_UploadMediaChunkedServer interface {
+	SendAndClose(*MediaResponse) error
+	Recv() (*MediaChunk, error)
+	grpc.ServerStream
+}
+
+type twitterUploadMediaChunkedServer struct {
+	grpc.ServerStream
+}
+
+func (x *twitterUploadMediaChunkedServer) SendAndClose(m *MediaResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *twitterUploadMediaChunkedServer) Recv() (*MediaChunk, error) {
+	m := new(MediaChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Twitter_GetMediaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MediaStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterServer).GetMediaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/twitter1.Twitter/GetMediaStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterServer).GetMediaStatus(ctx, req.(*MediaStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Twitter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "twitter1.Twitter",
 	HandlerType: (*TwitterServer)(nil),
@@ -4550,11 +10568,138 @@ var _Twitter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _Twitter_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "GetUser",
+			Handler:    _Twitter_GetUser_Handler,
+		},
+		{
+			MethodName: "GetUsers",
+			Handler:    _Twitter_GetUsers_Handler,
+		},
+		{
+			MethodName: "GetFollowerIds",
+			Handler:    _Twitter_GetFollowerIds_Handler,
+		},
+		{
+			MethodName: "GetFollowingIds",
+			Handler:    _Twitter_GetFollowingIds_Handler,
+		},
+		{
+			MethodName: "GetFollowers",
+			Handler:    _Twitter_GetFollowers_Handler,
+		},
+		{
+			MethodName: "GetFollowing",
+			Handler:    _Twitter_GetFollowing_Handler,
+		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _Twitter_FollowUser_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _Twitter_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Twitter_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Twitter_UnblockUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _Twitter_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _Twitter_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "GetRelationship",
+			Handler:    _Twitter_GetRelationship_Handler,
+		},
+		{
+			MethodName: "SendDirectMessage",
+			Handler:    _Twitter_SendDirectMessage_Handler,
+		},
+		{
+			MethodName: "ListDirectMessages",
+			Handler:    _Twitter_ListDirectMessages_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _Twitter_GetList_Handler,
+		},
+		{
+			MethodName: "GetListTimeline",
+			Handler:    _Twitter_GetListTimeline_Handler,
+		},
+		{
+			MethodName: "GetListMembers",
+			Handler:    _Twitter_GetListMembers_Handler,
+		},
+		{
+			MethodName: "AddListMember",
+			Handler:    _Twitter_AddListMember_Handler,
+		},
+		{
+			MethodName: "RemoveListMember",
+			Handler:    _Twitter_RemoveListMember_Handler,
+		},
+		{
+			MethodName: "GetOwnedLists",
+			Handler:    _Twitter_GetOwnedLists_Handler,
+		},
 		{
 			MethodName: "GetRaw",
 			Handler:    _Twitter_GetRaw_Handler,
 		},
+		{
+			MethodName: "GetRateLimits",
+			Handler:    _Twitter_GetRateLimits_Handler,
+		},
+		{
+			MethodName: "UploadMedia",
+			Handler:    _Twitter_UploadMedia_Handler,
+		},
+		{
+			MethodName: "GetMediaStatus",
+			Handler:    _Twitter_GetMediaStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFollowerIds",
+			Handler:       _Twitter_StreamFollowerIds_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFollowingIds",
+			Handler:       _Twitter_StreamFollowingIds_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFollowers",
+			Handler:       _Twitter_StreamFollowers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFollowing",
+			Handler:       _Twitter_StreamFollowing_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTweets",
+			Handler:       _Twitter_StreamTweets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadMediaChunked",
+			Handler:       _Twitter_UploadMediaChunked_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "twitter1.proto",
 }
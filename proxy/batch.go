@@ -0,0 +1,174 @@
+package proxy
+
+import (
+  "context"
+  "encoding/json"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/oauth"
+  "net/http"
+  "sync"
+  "time"
+)
+
+// The most ids statuses/lookup accepts at once
+const maxTweetBatch = 100
+
+// Merges GetTweet requests which are made with the same credentials, tweet options, priority and wait mode
+// within a short window into a single statuses/lookup request.
+type tweetBatcher struct {
+  tc      twitterClient
+  window  time.Duration
+  mx      sync.Mutex
+  pending map[string]*tweetBatch
+}
+
+type tweetBatch struct {
+  // The context of the first request in the batch, which the batch is sent with so that it keeps the request's
+  // logger, trace span and metadata. It is not cancelled if that request goes away.
+  ctx     context.Context
+  auth    oauth.AuthPair
+  query   oauth.Params
+  ids     []uint64
+  waiters map[uint64][]chan batchResult
+  // The latest deadline of the requests in the batch, unless one of them has no deadline
+  deadline   time.Time
+  noDeadline bool
+}
+
+type batchResult struct {
+  body []byte
+  err  error
+  sendResult
+}
+
+func newTweetBatcher(tc twitterClient, window time.Duration) *tweetBatcher {
+  return &tweetBatcher{
+    tc:      tc,
+    window:  window,
+    pending: make(map[string]*tweetBatch),
+  }
+}
+
+// Adds the tweet to the current batch for the credentials and tweet options, and returns the tweet's JSON
+// once the batch has been sent. query contains the tweet options, but not the id. Each request in the batch is
+// charged to its own client's statuses/show quota, as it would be without batching.
+func (b *tweetBatcher) get(ctx context.Context, auth oauth.AuthPair, id uint64, query oauth.Params) ([]byte, error) {
+  refund, err := b.tc.quotas.use(ctx, showTweetEndpoint)
+  if err != nil {
+    return nil, err
+  }
+  key := sharingKey(ctx, requestKey(showTweetsEndpoint, auth, query))
+  result := make(chan batchResult, 1)
+
+  b.mx.Lock()
+  batch, ok := b.pending[key]
+  if !ok {
+    batch = &tweetBatch{
+      ctx:     context.WithoutCancel(ctx),
+      auth:    auth,
+      query:   query,
+      waiters: make(map[uint64][]chan batchResult),
+    }
+    b.pending[key] = batch
+    time.AfterFunc(b.window, func() {
+      b.flush(key, batch)
+    })
+  }
+  if _, ok := batch.waiters[id]; !ok {
+    batch.ids = append(batch.ids, id)
+  }
+  batch.waiters[id] = append(batch.waiters[id], result)
+  if deadline, ok := ctx.Deadline(); !ok {
+    batch.noDeadline = true
+  } else if deadline.After(batch.deadline) {
+    batch.deadline = deadline
+  }
+  full := len(batch.ids) >= maxTweetBatch
+  if full {
+    delete(b.pending, key)
+  }
+  b.mx.Unlock()
+
+  if full {
+    go b.send(batch)
+  }
+
+  select {
+  case res := <-result:
+    if res.retries > 0 {
+      b.tc.reportRetries(ctx, showTweetsEndpoint, res.retries)
+    }
+    if res.err != nil && !res.sent {
+      refund()
+    }
+    return res.body, res.err
+  case <-ctx.Done():
+    return nil, ctx.Err()
+  }
+}
+
+func (b *tweetBatcher) flush(key string, batch *tweetBatch) {
+  b.mx.Lock()
+  // The batch may already have been sent because it filled up
+  if b.pending[key] != batch {
+    b.mx.Unlock()
+    return
+  }
+  delete(b.pending, key)
+  b.mx.Unlock()
+  b.send(batch)
+}
+
+func (b *tweetBatcher) send(batch *tweetBatch) {
+  query := oauth.NewParams()
+  query.Set("id", serIDList(batch.ids))
+  query.Extend(batch.query)
+
+  // The batch is shared by several requests, so it is not cancelled if any one of them goes away, but it gives
+  // up once none of them are waiting for it any more
+  ctx := batch.ctx
+  if !batch.noDeadline {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithDeadline(ctx, batch.deadline)
+    defer cancel()
+  }
+  // The requests in the batch have already been charged to their quotas
+  var tweets []json.RawMessage
+  sr, err := b.tc.send(ctx, func() (*http.Request, error) {
+    return b.tc.makeRequest(ctx, showTweetsEndpoint, batch.auth, query, nil)
+  }, showTweetsEndpoint, batch.auth, checkStatus(decodeJSON(&tweets)))
+
+  found := make(map[uint64][]byte, len(tweets))
+  if err == nil {
+    for _, body := range tweets {
+      var tweet struct {
+        ID uint64 `json:"id"`
+      }
+      if err = json.Unmarshal(body, &tweet); err != nil {
+        break
+      }
+      found[tweet.ID] = body
+    }
+  }
+
+  for id, waiters := range batch.waiters {
+    res := batchResult{sendResult: sr}
+    if err != nil {
+      res.err = err
+    } else if body, ok := found[id]; ok {
+      res.body = body
+    } else {
+      // statuses/lookup leaves out tweets that do not exist, so report them the same way statuses/show would
+      res.err = apiError{
+        code:        pb.Error_NOT_FOUND,
+        status:      "404 Not Found",
+        statusCode:  http.StatusNotFound,
+        twitterCode: 144,
+        message:     "No status found with that ID. (code 144)",
+      }
+    }
+    for _, waiter := range waiters {
+      waiter <- res
+    }
+  }
+}
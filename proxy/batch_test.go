@@ -0,0 +1,165 @@
+package proxy
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/oauth"
+  "google.golang.org/grpc/metadata"
+  "net/http"
+  "net/http/httptest"
+  "strings"
+  "sync"
+  "sync/atomic"
+  "testing"
+  "time"
+)
+
+func TestTweetBatcher(t *testing.T) {
+  var requests int32
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    atomic.AddInt32(&requests, 1)
+    var tweets []string
+    for _, id := range strings.Split(r.URL.Query().Get("id"), ",") {
+      // Tweet 3 does not exist
+      if id != "3" {
+        tweets = append(tweets, fmt.Sprintf(`{"id": %s}`, id))
+      }
+    }
+    fmt.Fprint(w, "["+strings.Join(tweets, ",")+"]")
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  batcher := newTweetBatcher(tc, 50*time.Millisecond)
+
+  ids := []uint64{1, 2, 3, 2}
+  bodies := make([][]byte, len(ids))
+  errs := make([]error, len(ids))
+  var wg sync.WaitGroup
+  for i, id := range ids {
+    wg.Add(1)
+    go func(i int, id uint64) {
+      defer wg.Done()
+      bodies[i], errs[i] = batcher.get(context.Background(), oauth.AuthPair{}, id, nil)
+    }(i, id)
+  }
+  wg.Wait()
+
+  if requests != 1 {
+    t.Errorf("got %d upstream requests, expected 1", requests)
+  }
+  for i, id := range ids {
+    if id == 3 {
+      if apiErr, ok := errs[i].(apiError); !ok || apiErr.code != pb.Error_NOT_FOUND {
+        t.Errorf("got error %v for missing tweet, expected not found error", errs[i])
+      }
+      continue
+    }
+    if errs[i] != nil {
+      t.Fatalf("tweet %d: %v", id, errs[i])
+    }
+    var tweet struct {
+      ID uint64 `json:"id"`
+    }
+    if err := json.Unmarshal(bodies[i], &tweet); err != nil || tweet.ID != id {
+      t.Errorf("got %s for tweet %d", bodies[i], id)
+    }
+  }
+}
+
+func TestTweetBatcherPriority(t *testing.T) {
+  var requests int32
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    atomic.AddInt32(&requests, 1)
+    fmt.Fprintf(w, `[{"id": %s}]`, r.URL.Query().Get("id"))
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  batcher := newTweetBatcher(tc, 50*time.Millisecond)
+
+  background := metadata.NewIncomingContext(context.Background(), metadata.Pairs(priorityMetaKey, "background"))
+  var wg sync.WaitGroup
+  for i, ctx := range []context.Context{context.Background(), background} {
+    wg.Add(1)
+    go func(id uint64, ctx context.Context) {
+      defer wg.Done()
+      if _, err := batcher.get(ctx, oauth.AuthPair{}, id, nil); err != nil {
+        t.Error(err)
+      }
+    }(uint64(i+1), ctx)
+  }
+  wg.Wait()
+
+  if requests != 2 {
+    t.Errorf("got %d upstream requests, expected requests with different priorities to be sent separately", requests)
+  }
+}
+
+func TestTweetBatcherQuotas(t *testing.T) {
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    var tweets []string
+    for _, id := range strings.Split(r.URL.Query().Get("id"), ",") {
+      tweets = append(tweets, fmt.Sprintf(`{"id": %s}`, id))
+    }
+    fmt.Fprint(w, "["+strings.Join(tweets, ",")+"]")
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  tc.quotas = newQuotas([]ClientIdentity{{
+    Name:    "crawler",
+    APIKeys: []string{"crawler-key"},
+    Quotas: map[string]Quota{
+      showTweetEndpoint.limitName(): {Requests: 1},
+    },
+  }})
+  batcher := newTweetBatcher(tc, 50*time.Millisecond)
+  crawler := metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyMetaKey, "crawler-key"))
+
+  getBoth := func() (crawlerErr, otherErr error) {
+    var wg sync.WaitGroup
+    wg.Add(2)
+    go func() {
+      defer wg.Done()
+      _, crawlerErr = batcher.get(crawler, oauth.AuthPair{}, 1, nil)
+    }()
+    go func() {
+      defer wg.Done()
+      _, otherErr = batcher.get(context.Background(), oauth.AuthPair{}, 2, nil)
+    }()
+    wg.Wait()
+    return crawlerErr, otherErr
+  }
+
+  // The batch is refused by the rate limit, so the crawler's unit of quota is given back
+  current, next := uint(0), uint(10)
+  tc.ses.get("").getLimit(showTweetsEndpoint.limitKey()).restore(LimitSnapshot{
+    Current: &current,
+    Next:    &next,
+    Resets:  time.Now().Add(time.Millisecond * 200),
+  })
+  crawlerErr, otherErr := getBoth()
+  if _, ok := crawlerErr.(rateLimitError); !ok {
+    t.Fatalf("crawler got %v, expected rate limit error", crawlerErr)
+  }
+  if _, ok := otherErr.(rateLimitError); !ok {
+    t.Fatalf("other client got %v, expected rate limit error", otherErr)
+  }
+
+  time.Sleep(time.Millisecond * 200)
+  if crawlerErr, otherErr = getBoth(); crawlerErr != nil || otherErr != nil {
+    t.Fatalf("got errors %v and %v after the rate limit reset", crawlerErr, otherErr)
+  }
+
+  // Only the crawler has used up its statuses/show quota, so only its request fails
+  crawlerErr, otherErr = getBoth()
+  if _, ok := crawlerErr.(quotaError); !ok {
+    t.Errorf("crawler got %v, expected quota error", crawlerErr)
+  }
+  if otherErr != nil {
+    t.Errorf("other client: %v", otherErr)
+  }
+}
@@ -0,0 +1,215 @@
+package proxy
+
+import (
+  "container/list"
+  "context"
+  "encoding/json"
+  "github.com/pantonshire/goldcrest/proxy/oauth"
+  "google.golang.org/grpc/metadata"
+  "strconv"
+  "sync"
+  "time"
+)
+
+const cacheMetaKey = "goldcrest-cache"
+
+// An in-memory cache of Twitter response bodies. When the cache is full, the least recently used entry is
+// evicted. Entries can be tagged with the ids of the objects they contain, so that they can be invalidated
+// when the proxy changes one of those objects.
+type responseCache struct {
+  mx         sync.Mutex
+  ttl        time.Duration
+  maxEntries int
+  entries    map[string]*list.Element
+  recent     *list.List
+  tagged     map[string]map[string]struct{}
+  fetching   map[string]*tagFetches
+}
+
+// Tracks the fetches in flight for entries with a tag, so that a fetch which started before the tag was
+// invalidated does not cache its possibly stale response afterwards. gen is incremented every time the tag
+// is invalidated while there are fetches in flight.
+type tagFetches struct {
+  count int
+  gen   uint64
+}
+
+type cacheEntry struct {
+  key     string
+  body    []byte
+  expires time.Time
+  tags    []string
+}
+
+func newResponseCache(ttl time.Duration, maxEntries int) *responseCache {
+  return &responseCache{
+    ttl:        ttl,
+    maxEntries: maxEntries,
+    entries:    make(map[string]*list.Element),
+    recent:     list.New(),
+    tagged:     make(map[string]map[string]struct{}),
+    fetching:   make(map[string]*tagFetches),
+  }
+}
+
+func tweetCacheTag(id uint64) string {
+  return "tweet:" + strconv.FormatUint(id, 10)
+}
+
+func (c *responseCache) get(key string) ([]byte, bool) {
+  c.mx.Lock()
+  defer c.mx.Unlock()
+  elem, ok := c.entries[key]
+  if !ok {
+    return nil, false
+  }
+  entry := elem.Value.(*cacheEntry)
+  if time.Now().After(entry.expires) {
+    c.remove(elem)
+    return nil, false
+  }
+  c.recent.MoveToFront(elem)
+  return entry.body, true
+}
+
+func (c *responseCache) put(key string, body []byte, tags []string) {
+  c.mx.Lock()
+  defer c.mx.Unlock()
+  c.putLocked(key, body, tags)
+}
+
+// Must be called with the lock held.
+func (c *responseCache) putLocked(key string, body []byte, tags []string) {
+  if elem, ok := c.entries[key]; ok {
+    c.remove(elem)
+  }
+  entry := &cacheEntry{
+    key:     key,
+    body:    body,
+    expires: time.Now().Add(c.ttl),
+    tags:    tags,
+  }
+  c.entries[key] = c.recent.PushFront(entry)
+  for _, tag := range tags {
+    if c.tagged[tag] == nil {
+      c.tagged[tag] = make(map[string]struct{})
+    }
+    c.tagged[tag][key] = struct{}{}
+  }
+  for c.recent.Len() > c.maxEntries {
+    c.remove(c.recent.Back())
+  }
+}
+
+// Records that a fetch for an entry with the given tags is starting, and returns the generation of each tag
+// to pass to endFetch.
+func (c *responseCache) startFetch(tags []string) []uint64 {
+  c.mx.Lock()
+  defer c.mx.Unlock()
+  gens := make([]uint64, len(tags))
+  for i, tag := range tags {
+    fetches := c.fetching[tag]
+    if fetches == nil {
+      fetches = &tagFetches{}
+      c.fetching[tag] = fetches
+    }
+    fetches.count++
+    gens[i] = fetches.gen
+  }
+  return gens
+}
+
+// Records that a fetch started with startFetch has finished. If ok is true, the body is cached unless one of
+// its tags was invalidated during the fetch.
+func (c *responseCache) endFetch(key string, body []byte, tags []string, gens []uint64, ok bool) {
+  c.mx.Lock()
+  defer c.mx.Unlock()
+  for i, tag := range tags {
+    fetches := c.fetching[tag]
+    if fetches.gen != gens[i] {
+      ok = false
+    }
+    fetches.count--
+    if fetches.count == 0 {
+      delete(c.fetching, tag)
+    }
+  }
+  if ok {
+    c.putLocked(key, body, tags)
+  }
+}
+
+// Removes every entry with the given tag, regardless of which token it was cached for.
+func (c *responseCache) invalidate(tag string) {
+  c.mx.Lock()
+  defer c.mx.Unlock()
+  if fetches := c.fetching[tag]; fetches != nil {
+    fetches.gen++
+  }
+  for key := range c.tagged[tag] {
+    if elem, ok := c.entries[key]; ok {
+      c.remove(elem)
+    }
+  }
+}
+
+// Must be called with the lock held.
+func (c *responseCache) remove(elem *list.Element) {
+  entry := c.recent.Remove(elem).(*cacheEntry)
+  delete(c.entries, entry.key)
+  for _, tag := range entry.tags {
+    delete(c.tagged[tag], entry.key)
+    if len(c.tagged[tag]) == 0 {
+      delete(c.tagged, tag)
+    }
+  }
+}
+
+func cacheMeta(hit bool) metadata.MD {
+  if hit {
+    return metadata.Pairs(cacheMetaKey, "hit")
+  }
+  return metadata.Pairs(cacheMetaKey, "miss")
+}
+
+// Like standardRequest, but if the response cache is enabled, the response body is cached and is reused by
+// later identical requests until it expires. The returned metadata reports whether the cache was hit.
+func (tc twitterClient) cachedRequest(ctx context.Context, ep endpoint, auth oauth.AuthPair, query oauth.Params, tags []string, output interface{}) (metadata.MD, error) {
+  return tc.cachedFetch(requestKey(ep, auth, query), tags, output, func() ([]byte, error) {
+    return tc.fetchBody(ctx, ep, auth, query, nil)
+  })
+}
+
+// Decodes the cached body with the given key into output if there is one, and otherwise calls fetch to get
+// the body and caches it. The body is not cached if one of the tags is invalidated while it is being fetched.
+func (tc twitterClient) cachedFetch(key string, tags []string, output interface{}, fetch func() ([]byte, error)) (metadata.MD, error) {
+  if tc.cache == nil {
+    body, err := fetch()
+    if err != nil {
+      return nil, err
+    }
+    return nil, json.Unmarshal(body, output)
+  }
+  if body, ok := tc.cache.get(key); ok {
+    if err := json.Unmarshal(body, output); err != nil {
+      return nil, err
+    }
+    return cacheMeta(true), nil
+  }
+  gens := tc.cache.startFetch(tags)
+  body, err := fetch()
+  if err == nil {
+    err = json.Unmarshal(body, output)
+  }
+  tc.cache.endFetch(key, body, tags, gens, err == nil)
+  if err != nil {
+    return nil, err
+  }
+  return cacheMeta(false), nil
+}
+
+func (tc twitterClient) invalidate(tag string) {
+  if tc.cache != nil {
+    tc.cache.invalidate(tag)
+  }
+}
@@ -0,0 +1,69 @@
+package proxy
+
+import (
+  "testing"
+  "time"
+)
+
+func TestResponseCache(t *testing.T) {
+  cache := newResponseCache(time.Minute, 2)
+
+  cache.put("a", []byte("1"), []string{tweetCacheTag(1)})
+  cache.put("b", []byte("2"), []string{tweetCacheTag(1), tweetCacheTag(2)})
+  if body, ok := cache.get("a"); !ok || string(body) != "1" {
+    t.Fatalf("got %q, %v for a, expected \"1\", true", body, ok)
+  }
+
+  // b is now the least recently used entry, so it should be evicted first
+  cache.put("c", []byte("3"), []string{tweetCacheTag(3)})
+  if _, ok := cache.get("b"); ok {
+    t.Error("b should have been evicted")
+  }
+
+  cache.invalidate(tweetCacheTag(1))
+  if _, ok := cache.get("a"); ok {
+    t.Error("a should have been invalidated")
+  }
+  if _, ok := cache.get("c"); !ok {
+    t.Error("c should not have been invalidated")
+  }
+
+  cache.ttl = -time.Second
+  cache.put("d", []byte("4"), nil)
+  if _, ok := cache.get("d"); ok {
+    t.Error("d should have expired")
+  }
+}
+
+func TestResponseCacheInvalidateDuringFetch(t *testing.T) {
+  tc := twitterClient{cache: newResponseCache(time.Minute, 10)}
+  tags := []string{tweetCacheTag(1)}
+  var out int
+
+  // The tweet is changed while its old version is being fetched, so the old version should not be cached
+  _, err := tc.cachedFetch("a", tags, &out, func() ([]byte, error) {
+    tc.invalidate(tweetCacheTag(1))
+    return []byte("1"), nil
+  })
+  if err != nil || out != 1 {
+    t.Fatalf("got %d, %v, expected 1, nil", out, err)
+  }
+  if _, ok := tc.cache.get("a"); ok {
+    t.Error("a should not have been cached after being invalidated during the fetch")
+  }
+
+  // Invalidating an unrelated tag should not stop the body being cached
+  _, err = tc.cachedFetch("a", tags, &out, func() ([]byte, error) {
+    tc.invalidate(tweetCacheTag(2))
+    return []byte("2"), nil
+  })
+  if err != nil {
+    t.Fatalf("unexpected error: %v", err)
+  }
+  if body, ok := tc.cache.get("a"); !ok || string(body) != "2" {
+    t.Errorf("got %q, %v for a, expected \"2\", true", body, ok)
+  }
+  if len(tc.cache.fetching) != 0 {
+    t.Errorf("%d tags still recorded as being fetched", len(tc.cache.fetching))
+  }
+}
@@ -0,0 +1,44 @@
+package proxy
+
+import (
+  "context"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "google.golang.org/grpc/metadata"
+)
+
+func (p Proxy) SendDirectMessage(ctx context.Context, req *pb.SendDirectMessageRequest) (*pb.DirectMessageResponse, error) {
+  auth, body := reserSendDirectMessageRequest(req)
+  resp, meta, err := generateDirectMessageResponse(func() (model.DirectMessageEvent, metadata.MD, error) {
+    var result model.DirectMessageEventResult
+    if err := p.tc.jsonRequest(ctx, sendDMEndpoint, auth, nil, body, &result); err != nil {
+      return model.DirectMessageEvent{}, nil, err
+    }
+    return result.Event, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) ListDirectMessages(ctx context.Context, req *pb.ListDirectMessagesRequest) (*pb.DirectMessagesResponse, error) {
+  auth, query := reserListDirectMessagesRequest(req)
+  resp, meta, err := generateDirectMessagesResponse(func() (model.DirectMessageEventList, metadata.MD, error) {
+    var list model.DirectMessageEventList
+    if err := p.tc.standardRequest(ctx, listDMsEndpoint, auth, query, nil, &list); err != nil {
+      return model.DirectMessageEventList{}, nil, err
+    }
+    return list, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
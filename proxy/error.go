@@ -1,10 +1,14 @@
 package proxy
 
 import (
+  "encoding/json"
   "fmt"
   pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
   "google.golang.org/grpc/metadata"
+  "net/http"
   "strconv"
+  "strings"
   "time"
 )
 
@@ -36,40 +40,118 @@ func (err rateLimitError) ser() (*pb.Error, metadata.MD) {
   }, meta
 }
 
-type twitterError struct {
-  message string
-}
-
-func newTwitterError(message string) twitterError {
-  return twitterError{message: message}
+// An error response from Twitter.
+type apiError struct {
+  code        pb.Error_Code
+  status      string
+  statusCode  int
+  twitterCode uint32
+  message     string
+}
+
+// Error codes which Twitter gives in the body of error responses, for the errors which clients are likely to
+// want to handle differently.
+var twitterErrorCodes = map[uint32]pb.Error_Code{
+  8:   pb.Error_NOT_FOUND,    // No data available for specified ID
+  17:  pb.Error_NOT_FOUND,    // No user matches for specified terms
+  32:  pb.Error_UNAUTHORIZED, // Could not authenticate you
+  34:  pb.Error_NOT_FOUND,    // Sorry, that page does not exist
+  50:  pb.Error_NOT_FOUND,    // User not found
+  63:  pb.Error_FORBIDDEN,    // User has been suspended
+  64:  pb.Error_FORBIDDEN,    // Your account is suspended and is not permitted to access this feature
+  89:  pb.Error_UNAUTHORIZED, // Invalid or expired token
+  136: pb.Error_FORBIDDEN,    // You have been blocked from the author of this tweet
+  139: pb.Error_DUPLICATE,    // You have already favorited this status
+  144: pb.Error_NOT_FOUND,    // No status found with that ID
+  160: pb.Error_DUPLICATE,    // You've already requested to follow the user
+  179: pb.Error_FORBIDDEN,    // Sorry, you are not authorized to see this status
+  187: pb.Error_DUPLICATE,    // Status is a duplicate
+  215: pb.Error_UNAUTHORIZED, // Bad authentication data
+  220: pb.Error_FORBIDDEN,    // Your credentials do not allow access to this resource
+  261: pb.Error_FORBIDDEN,    // Application cannot perform write actions
+  326: pb.Error_FORBIDDEN,    // Your account is temporarily locked
+  327: pb.Error_DUPLICATE,    // You have already retweeted this Tweet
+}
+
+// Parses the error from the body of a non-2xx response. The code is chosen from the first of Twitter's error
+// codes, or from the HTTP status if the code is not one of twitterErrorCodes.
+func newAPIError(statusCode int, status string, body []byte) apiError {
+  err := apiError{
+    code:       statusErrorCode(statusCode),
+    status:     status,
+    statusCode: statusCode,
+    message:    string(body),
+  }
+  var errResp model.ErrorResponse
+  if json.Unmarshal(body, &errResp) != nil {
+    return err
+  }
+  if len(errResp.Errors) > 0 {
+    err.twitterCode = errResp.Errors[0].Code
+    if code, ok := twitterErrorCodes[err.twitterCode]; ok {
+      err.code = code
+    }
+    msgs := make([]string, len(errResp.Errors))
+    for i, detail := range errResp.Errors {
+      msgs[i] = fmt.Sprintf("%s (code %d)", detail.Message, detail.Code)
+    }
+    err.message = strings.Join(msgs, "; ")
+  } else if errResp.Error != "" {
+    err.message = errResp.Error
+  }
+  return err
+}
+
+func statusErrorCode(statusCode int) pb.Error_Code {
+  switch {
+  case statusCode == http.StatusNotFound:
+    return pb.Error_NOT_FOUND
+  case statusCode == http.StatusUnauthorized:
+    return pb.Error_UNAUTHORIZED
+  case statusCode == http.StatusForbidden:
+    return pb.Error_FORBIDDEN
+  case 400 <= statusCode && statusCode < 500:
+    return pb.Error_BAD_REQUEST
+  default:
+    return pb.Error_TWITTER_ERROR
+  }
 }
 
-func (err twitterError) Error() string {
-  return fmt.Sprintf("twitter connection error: %s", err.message)
+func (err apiError) Error() string {
+  return fmt.Sprintf("Twitter responded with %s: %s", err.status, err.message)
 }
 
-func (err twitterError) ser() (*pb.Error, metadata.MD) {
+func (err apiError) ser() (*pb.Error, metadata.MD) {
   return &pb.Error{
-    Code:    pb.Error_TWITTER_ERROR,
-    Message: err.Error(),
+    Code:        err.code,
+    Message:     err.Error(),
+    TwitterCode: err.twitterCode,
+    HttpStatus:  uint32(err.statusCode),
   }, nil
 }
 
-type badRequestError struct {
-  message string
+// A request to Twitter which failed without a response, e.g. because the connection was refused or reset.
+type connectionError struct {
+  err error
+  // Whether any of the request was written to the connection before it failed
+  sent bool
+}
+
+func newConnectionError(err error, sent bool) connectionError {
+  return connectionError{err: err, sent: sent}
 }
 
-func newBadRequestError(message string) badRequestError {
-  return badRequestError{message: message}
+func (err connectionError) Error() string {
+  return fmt.Sprintf("twitter connection error: %v", err.err)
 }
 
-func (err badRequestError) Error() string {
-  return fmt.Sprintf("proxy sent bad request: %s", err.message)
+func (err connectionError) Unwrap() error {
+  return err.err
 }
 
-func (err badRequestError) ser() (*pb.Error, metadata.MD) {
+func (err connectionError) ser() (*pb.Error, metadata.MD) {
   return &pb.Error{
-    Code:    pb.Error_BAD_REQUEST,
+    Code:    pb.Error_TWITTER_ERROR,
     Message: err.Error(),
   }, nil
 }
@@ -92,3 +174,23 @@ func (err badResponseError) ser() (*pb.Error, metadata.MD) {
     Message: err.Error(),
   }, nil
 }
+
+type quotaError struct {
+  client, limit string
+  retry         time.Time
+}
+
+func newQuotaError(client, limit string, retry time.Time) quotaError {
+  return quotaError{client: client, limit: limit, retry: retry}
+}
+
+func (err quotaError) Error() string {
+  return fmt.Sprintf("quota for client %q exceeded for %s; resets at %s", err.client, err.limit, err.retry.Format("15:04:05 MST"))
+}
+
+func (err quotaError) ser() (*pb.Error, metadata.MD) {
+  return &pb.Error{
+    Code:    pb.Error_QUOTA_EXCEEDED,
+    Message: err.Error(),
+  }, metadata.Pairs("retry", strconv.FormatInt(err.retry.Unix(), 10))
+}
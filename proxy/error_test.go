@@ -0,0 +1,56 @@
+package proxy
+
+import (
+  "context"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "google.golang.org/grpc"
+  "testing"
+)
+
+func TestAPIError(t *testing.T) {
+  tests := []struct {
+    statusCode  int
+    body        string
+    code        pb.Error_Code
+    twitterCode uint32
+  }{
+    {404, `{"errors":[{"code":144,"message":"No status found with that ID."}]}`, pb.Error_NOT_FOUND, 144},
+    {403, `{"errors":[{"code":187,"message":"Status is a duplicate."}]}`, pb.Error_DUPLICATE, 187},
+    {403, `{"errors":[{"code":179,"message":"Sorry, you are not authorized to see this status."}]}`, pb.Error_FORBIDDEN, 179},
+    {401, `{"errors":[{"code":89,"message":"Invalid or expired token."}]}`, pb.Error_UNAUTHORIZED, 89},
+    {403, `{"errors":[{"code":64,"message":"Your account is suspended and is not permitted to access this feature."}]}`, pb.Error_FORBIDDEN, 64},
+    {400, `{"errors":[{"code":44,"message":"attachment_url parameter is invalid."}]}`, pb.Error_BAD_REQUEST, 44},
+    {400, `{"request":"/1.1/media/upload.json","error":"media type unrecognized."}`, pb.Error_BAD_REQUEST, 0},
+    {404, `not json`, pb.Error_NOT_FOUND, 0},
+    {503, `{"errors":[{"code":130,"message":"Over capacity"}]}`, pb.Error_TWITTER_ERROR, 130},
+  }
+
+  for _, test := range tests {
+    msg, _ := newAPIError(test.statusCode, "", []byte(test.body)).ser()
+    if msg.GetCode() != test.code {
+      t.Errorf("got code %v for %s, expected %v", msg.GetCode(), test.body, test.code)
+    }
+    if msg.GetTwitterCode() != test.twitterCode {
+      t.Errorf("got twitter code %d for %s, expected %d", msg.GetTwitterCode(), test.body, test.twitterCode)
+    }
+    if msg.GetHttpStatus() != uint32(test.statusCode) {
+      t.Errorf("got http status %d for %s, expected %d", msg.GetHttpStatus(), test.body, test.statusCode)
+    }
+  }
+}
+
+func TestTooManyUsers(t *testing.T) {
+  p, err := NewProxy(nil, Config{TwitterProtocol: "http", TwitterURL: "127.0.0.1:1"})
+  if err != nil {
+    t.Fatalf("creating proxy: %v", err)
+  }
+  defer p.Close()
+  ctx := grpc.NewContextWithServerTransportStream(context.Background(), &headerStream{})
+  resp, err := p.GetUsers(ctx, &pb.UsersRequest{UserIds: make([]uint64, maxUserLookup+1)})
+  if err != nil {
+    t.Fatalf("got error %v, expected a response", err)
+  }
+  if resp.GetError().GetCode() != pb.Error_BAD_REQUEST {
+    t.Errorf("got response %v, expected a bad request error", resp)
+  }
+}
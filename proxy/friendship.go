@@ -0,0 +1,96 @@
+package proxy
+
+import (
+  "context"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "google.golang.org/grpc/metadata"
+)
+
+func (p Proxy) FollowUser(ctx context.Context, req *pb.UserRequest) (*pb.RelationshipResponse, error) {
+  return p.changeRelationship(ctx, followEndpoint, req, func(rel *model.Relationship) {
+    // Following a protected user only sends a follow request
+    rel.FollowingRequested = rel.User.Protected && !rel.Following
+    rel.Following = !rel.FollowingRequested
+  })
+}
+
+func (p Proxy) UnfollowUser(ctx context.Context, req *pb.UserRequest) (*pb.RelationshipResponse, error) {
+  return p.changeRelationship(ctx, unfollowEndpoint, req, func(rel *model.Relationship) {
+    rel.Following = false
+    rel.FollowingRequested = false
+    rel.NotificationsEnabled = false
+  })
+}
+
+func (p Proxy) BlockUser(ctx context.Context, req *pb.UserRequest) (*pb.RelationshipResponse, error) {
+  return p.changeRelationship(ctx, blockEndpoint, req, func(rel *model.Relationship) {
+    // Blocking a user also unfollows them
+    rel.Blocking = knownBool(true)
+    rel.Following = false
+    rel.FollowingRequested = false
+    rel.NotificationsEnabled = false
+  })
+}
+
+func (p Proxy) UnblockUser(ctx context.Context, req *pb.UserRequest) (*pb.RelationshipResponse, error) {
+  return p.changeRelationship(ctx, unblockEndpoint, req, func(rel *model.Relationship) {
+    rel.Blocking = knownBool(false)
+  })
+}
+
+func (p Proxy) MuteUser(ctx context.Context, req *pb.UserRequest) (*pb.RelationshipResponse, error) {
+  return p.changeRelationship(ctx, muteEndpoint, req, func(rel *model.Relationship) {
+    rel.Muting = knownBool(true)
+  })
+}
+
+func (p Proxy) UnmuteUser(ctx context.Context, req *pb.UserRequest) (*pb.RelationshipResponse, error) {
+  return p.changeRelationship(ctx, unmuteEndpoint, req, func(rel *model.Relationship) {
+    rel.Muting = knownBool(false)
+  })
+}
+
+func (p Proxy) GetRelationship(ctx context.Context, req *pb.UserRequest) (*pb.RelationshipResponse, error) {
+  auth, query := reserRelationshipRequest(req)
+  resp, meta, err := generateRelationshipResponse(func() (model.Relationship, metadata.MD, error) {
+    var friendship model.Friendship
+    if err := p.tc.standardRequest(ctx, showFriendshipEndpoint, auth, query, nil, &friendship); err != nil {
+      return model.Relationship{}, nil, err
+    }
+    return friendship.Relationship(), nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+// Twitter may return the user as it was before the action took effect, so apply corrects the relationship
+// to reflect the action.
+func (p Proxy) changeRelationship(ctx context.Context, ep endpoint, req *pb.UserRequest, apply func(rel *model.Relationship)) (*pb.RelationshipResponse, error) {
+  auth, query := reserUserRequest(req)
+  resp, meta, err := generateRelationshipResponse(func() (model.Relationship, metadata.MD, error) {
+    var user model.User
+    if err := p.tc.standardRequest(ctx, ep, auth, query, nil, &user); err != nil {
+      return model.Relationship{}, nil, err
+    }
+    rel := user.Relationship()
+    apply(&rel)
+    return rel, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func knownBool(b bool) *bool {
+  return &b
+}
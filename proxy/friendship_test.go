@@ -0,0 +1,62 @@
+package proxy
+
+import (
+  "context"
+  "fmt"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "google.golang.org/grpc"
+  "net/http"
+  "net/http/httptest"
+  "testing"
+)
+
+func TestRelationships(t *testing.T) {
+  var paths []string
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    paths = append(paths, r.URL.Path)
+    switch r.URL.Path {
+    case "/" + showFriendshipEndpoint.path:
+      fmt.Fprint(w, `{"relationship": {
+        "source": {"following": true, "blocking": false, "muting": true},
+        "target": {"id": 12, "screen_name": "someone"}
+      }}`)
+    case "/" + followEndpoint.path:
+      fmt.Fprint(w, `{"id": 12, "screen_name": "someone", "created_at": "Wed Oct 10 20:19:24 +0000 2018"}`)
+    default:
+      t.Errorf("unexpected request to %s", r.URL.Path)
+      w.WriteHeader(http.StatusNotFound)
+    }
+  }))
+  defer server.Close()
+
+  p, err := NewProxy(nil, Config{TwitterProtocol: "http", TwitterURL: server.Listener.Addr().String()})
+  if err != nil {
+    t.Fatalf("creating proxy: %v", err)
+  }
+  defer p.Close()
+  ctx := grpc.NewContextWithServerTransportStream(context.Background(), &headerStream{})
+  req := &pb.UserRequest{User: &pb.UserRequest_UserId{UserId: 12}}
+
+  // friendships/show has everything GetRelationship needs, so no other requests are made
+  resp, err := p.GetRelationship(ctx, req)
+  if err != nil {
+    t.Fatalf("GetRelationship: %v", err)
+  }
+  rel := resp.GetRelationship()
+  if rel == nil || !rel.GetFollowing() || rel.GetBlocking() == nil || rel.GetBlocking().GetVal() || !rel.GetMuting().GetVal() {
+    t.Errorf("got relationship %v", rel)
+  }
+  if len(paths) != 1 {
+    t.Errorf("got requests to %v, expected only friendships/show", paths)
+  }
+
+  // The user returned by friendships/create does not say whether the user is blocked or muted
+  resp, err = p.FollowUser(ctx, req)
+  if err != nil {
+    t.Fatalf("FollowUser: %v", err)
+  }
+  rel = resp.GetRelationship()
+  if rel == nil || !rel.GetFollowing() || rel.GetBlocking() != nil || rel.GetMuting() != nil {
+    t.Errorf("got relationship %v after following", rel)
+  }
+}
@@ -0,0 +1,147 @@
+package proxy
+
+import (
+  "context"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/metadata"
+)
+
+type userIDPageStream interface {
+  Send(*pb.UserIdsResponse) error
+  grpc.ServerStream
+}
+
+type userPageStream interface {
+  Send(*pb.UserPageResponse) error
+  grpc.ServerStream
+}
+
+func (p Proxy) GetFollowerIds(ctx context.Context, req *pb.UserGraphRequest) (*pb.UserIdsResponse, error) {
+  return p.getUserIDPage(ctx, followerIDsEndpoint, req)
+}
+
+func (p Proxy) GetFollowingIds(ctx context.Context, req *pb.UserGraphRequest) (*pb.UserIdsResponse, error) {
+  return p.getUserIDPage(ctx, followingIDsEndpoint, req)
+}
+
+func (p Proxy) GetFollowers(ctx context.Context, req *pb.UserGraphRequest) (*pb.UserPageResponse, error) {
+  return p.getUserPage(ctx, followersEndpoint, req)
+}
+
+func (p Proxy) GetFollowing(ctx context.Context, req *pb.UserGraphRequest) (*pb.UserPageResponse, error) {
+  return p.getUserPage(ctx, followingEndpoint, req)
+}
+
+func (p Proxy) StreamFollowerIds(req *pb.UserGraphRequest, stream pb.Twitter_StreamFollowerIdsServer) error {
+  return p.streamUserIDPages(followerIDsEndpoint, req, stream)
+}
+
+func (p Proxy) StreamFollowingIds(req *pb.UserGraphRequest, stream pb.Twitter_StreamFollowingIdsServer) error {
+  return p.streamUserIDPages(followingIDsEndpoint, req, stream)
+}
+
+func (p Proxy) StreamFollowers(req *pb.UserGraphRequest, stream pb.Twitter_StreamFollowersServer) error {
+  return p.streamUserPages(followersEndpoint, req, stream)
+}
+
+func (p Proxy) StreamFollowing(req *pb.UserGraphRequest, stream pb.Twitter_StreamFollowingServer) error {
+  return p.streamUserPages(followingEndpoint, req, stream)
+}
+
+func (p Proxy) getUserIDPage(ctx context.Context, ep endpoint, req *pb.UserGraphRequest) (*pb.UserIdsResponse, error) {
+  auth := desAuth(req.GetAuth())
+  query := desCursorOptions(req.GetCursorOptions()).ser()
+  query.Extend(reserUserGraphParams(req, false))
+  resp, meta, err := generateUserIDsResponse(func() (model.UserIDPage, metadata.MD, error) {
+    var page model.UserIDPage
+    if err := p.tc.standardRequest(ctx, ep, auth, query, nil, &page); err != nil {
+      return model.UserIDPage{}, nil, err
+    }
+    return page, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) getUserPage(ctx context.Context, ep endpoint, req *pb.UserGraphRequest) (*pb.UserPageResponse, error) {
+  auth := desAuth(req.GetAuth())
+  query := desCursorOptions(req.GetCursorOptions()).ser()
+  query.Extend(reserUserGraphParams(req, true))
+  resp, meta, err := generateUserPageResponse(func() (model.UserPage, metadata.MD, error) {
+    var page model.UserPage
+    if err := p.tc.standardRequest(ctx, ep, auth, query, nil, &page); err != nil {
+      return model.UserPage{}, nil, err
+    }
+    return page, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) streamUserIDPages(ep endpoint, req *pb.UserGraphRequest, stream userIDPageStream) (err error) {
+  auth := desAuth(req.GetAuth())
+  params := reserUserGraphParams(req, false)
+  ctx, done := p.streamContext(stream)
+  defer func() {
+    err = p.streamErr(done(), err)
+  }()
+  err = walkCursor(ctx, desCursorOptions(req.GetCursorOptions()), func(opts cursorOptions) (int64, error) {
+    query := opts.ser()
+    query.Extend(params)
+    var page model.UserIDPage
+    if err := p.tc.standardRequest(ctx, ep, auth, query, nil, &page); err != nil {
+      return 0, err
+    }
+    if err := stream.Send(&pb.UserIdsResponse{Response: &pb.UserIdsResponse_Page{Page: serUserIDPage(page)}}); err != nil {
+      return 0, err
+    }
+    return page.NextCursor, nil
+  })
+  if errMsg, errMeta := serError(err); errMsg != nil {
+    if errMeta != nil {
+      stream.SetTrailer(errMeta)
+    }
+    return stream.Send(&pb.UserIdsResponse{Response: &pb.UserIdsResponse_Error{Error: errMsg}})
+  }
+  return err
+}
+
+func (p Proxy) streamUserPages(ep endpoint, req *pb.UserGraphRequest, stream userPageStream) (err error) {
+  auth := desAuth(req.GetAuth())
+  params := reserUserGraphParams(req, true)
+  ctx, done := p.streamContext(stream)
+  defer func() {
+    err = p.streamErr(done(), err)
+  }()
+  err = walkCursor(ctx, desCursorOptions(req.GetCursorOptions()), func(opts cursorOptions) (int64, error) {
+    query := opts.ser()
+    query.Extend(params)
+    var page model.UserPage
+    if err := p.tc.standardRequest(ctx, ep, auth, query, nil, &page); err != nil {
+      return 0, err
+    }
+    if err := stream.Send(&pb.UserPageResponse{Response: &pb.UserPageResponse_Page{Page: serUserPage(page)}}); err != nil {
+      return 0, err
+    }
+    return page.NextCursor, nil
+  })
+  if errMsg, errMeta := serError(err); errMsg != nil {
+    if errMeta != nil {
+      stream.SetTrailer(errMeta)
+    }
+    return stream.Send(&pb.UserPageResponse{Response: &pb.UserPageResponse_Error{Error: errMsg}})
+  }
+  return err
+}
@@ -0,0 +1,118 @@
+package proxy
+
+import (
+  "context"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "github.com/pantonshire/goldcrest/proxy/oauth"
+  "google.golang.org/grpc/metadata"
+  "strings"
+  "time"
+)
+
+const (
+  // How long to spend fetching the rate limits for an access token before giving up
+  warmUpTimeout = time.Second * 10
+  // How long to wait before trying to fetch the rate limits for an access token again after failing to
+  warmUpRetryInterval = time.Minute
+)
+
+func (p Proxy) GetRateLimits(ctx context.Context, req *pb.RateLimitsRequest) (*pb.RateLimitsResponse, error) {
+  auth := desAuth(req.GetAuth())
+  resp, meta, err := generateRateLimitsResponse(func() (map[string]LimitSnapshot, metadata.MD, error) {
+    return p.tc.session(ctx, auth).snapshot(), nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+// Returns the session for the access token. If warming up is enabled, the first call for each access token
+// starts seeding the session's rate limits in the background with those reported by
+// application/rate_limit_status, so that they do not each have to be resolved by making a request to the
+// endpoint. Requests carry on in the meantime as they would if warming up were disabled. If it fails, it is
+// tried again by a later call once warmUpRetryInterval has passed.
+func (tc twitterClient) session(ctx context.Context, auth oauth.AuthPair) *session {
+  se := tc.ses.get(auth.Public.Token)
+  if !tc.warmUp {
+    return se
+  }
+  se.warmUpMx.Lock()
+  defer se.warmUpMx.Unlock()
+  if se.warmedUp || se.warmingUp || time.Now().Before(se.warmUpRetry) {
+    return se
+  }
+  se.warmingUp = true
+  go tc.backgroundWarmUp(ctx, auth, se)
+  return se
+}
+
+func (tc twitterClient) backgroundWarmUp(ctx context.Context, auth oauth.AuthPair, se *session) {
+  // The rate limits are shared by every request using the token, so fetching them is not cut short if the
+  // request which started it goes away
+  warmUpCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), warmUpTimeout)
+  defer cancel()
+  err := tc.warmUpSession(warmUpCtx, auth, se)
+  se.warmUpMx.Lock()
+  defer se.warmUpMx.Unlock()
+  se.warmingUp = false
+  if err != nil {
+    tc.logger(ctx).WithError(err).Warn("Failed to fetch rate limit status")
+    se.warmUpRetry = time.Now().Add(warmUpRetryInterval)
+    return
+  }
+  se.warmedUp = true
+}
+
+// Makes the request directly rather than through rawRequest, since rawRequest would need the session to be
+// warmed up first. Limits which requests made in the meantime have already learned about are left alone, since
+// they are at least as up to date.
+func (tc twitterClient) warmUpSession(ctx context.Context, auth oauth.AuthPair, se *session) error {
+  req, err := tc.makeRequest(ctx, rateLimitStatusEndpoint, auth, nil, nil)
+  if err != nil {
+    return err
+  }
+  resp, err := tc.client.Do(req.WithContext(ctx))
+  if err != nil {
+    return err
+  }
+  defer resp.Body.Close()
+  if resp.StatusCode != 200 {
+    return newBadResponseError("Twitter responded to rate limit status request with " + resp.Status)
+  }
+  var status model.RateLimitStatus
+  if err := decodeJSON(&status)(resp); err != nil {
+    return err
+  }
+  for _, resources := range status.Resources {
+    for path, resource := range resources {
+      rl := se.getLimit(resourceLimitKey(path))
+      if _, known := rl.snapshot(); known {
+        continue
+      }
+      current, next := resource.Remaining, resource.Limit
+      rl.restore(LimitSnapshot{
+        Current: &current,
+        Next:    &next,
+        Resets:  time.Unix(resource.Reset, 0),
+      })
+    }
+  }
+  return nil
+}
+
+// Converts a path as it appears in application/rate_limit_status (e.g. "/statuses/show/:id") to the key the
+// proxy uses for the endpoint's rate limit (e.g. "singleton:statuses/show.json").
+func resourceLimitKey(path string) string {
+  var segments []string
+  for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+    if !strings.HasPrefix(segment, ":") {
+      segments = append(segments, segment)
+    }
+  }
+  return endpoint{path: strings.Join(segments, "/") + ".json"}.limitKey()
+}
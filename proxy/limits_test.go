@@ -0,0 +1,125 @@
+package proxy
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+  "net/http/httptest"
+  "sync/atomic"
+  "testing"
+  "time"
+)
+
+// Waits for the session to finish warming up in the background.
+func awaitWarmUp(t *testing.T, se *session) {
+  deadline := time.Now().Add(time.Second * 5)
+  for {
+    se.warmUpMx.Lock()
+    warmingUp := se.warmingUp
+    se.warmUpMx.Unlock()
+    if !warmingUp {
+      return
+    }
+    if time.Now().After(deadline) {
+      t.Fatal("timed out waiting for the session to warm up")
+    }
+    time.Sleep(time.Millisecond * 10)
+  }
+}
+
+func TestWarmUpSession(t *testing.T) {
+  resets := time.Now().Add(time.Minute).Unix()
+  release := make(chan struct{})
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    <-release
+    if r.URL.Path != "/"+rateLimitStatusEndpoint.path {
+      t.Errorf("unexpected request to %s", r.URL.Path)
+      w.WriteHeader(http.StatusNotFound)
+      return
+    }
+    fmt.Fprintf(w, `{"resources": {"statuses": {
+      "/statuses/show/:id": {"limit": 900, "remaining": 899, "reset": %d},
+      "/statuses/user_timeline": {"limit": 900, "remaining": 12, "reset": %d}
+    }}}`, resets, resets)
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  tc.warmUp = true
+  auth, _ := reserTweetRequest(nil)
+
+  // Requests should not wait for the rate limits to be fetched
+  se := tc.session(context.Background(), auth)
+  if limits := se.snapshot(); len(limits) != 0 {
+    t.Fatalf("got %d limits before warming up finished, expected 0", len(limits))
+  }
+  close(release)
+  awaitWarmUp(t, se)
+
+  limits := se.snapshot()
+  if len(limits) != 2 {
+    t.Fatalf("got %d limits, expected 2", len(limits))
+  }
+  snapshot, ok := limits[userTimelineEndpoint.limitKey()]
+  if !ok {
+    t.Fatalf("no limit for %s", userTimelineEndpoint.limitKey())
+  }
+  if snapshot.Current == nil || *snapshot.Current != 12 {
+    t.Errorf("got current %v, expected 12", snapshot.Current)
+  }
+  if snapshot.Next == nil || *snapshot.Next != 900 {
+    t.Errorf("got next %v, expected 900", snapshot.Next)
+  }
+  if snapshot.Resets.Unix() != resets {
+    t.Errorf("got resets %d, expected %d", snapshot.Resets.Unix(), resets)
+  }
+  if _, ok := limits[showTweetEndpoint.limitKey()]; !ok {
+    t.Errorf("no limit for %s", showTweetEndpoint.limitKey())
+  }
+}
+
+func TestWarmUpSessionRetry(t *testing.T) {
+  var requests int32
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    if atomic.AddInt32(&requests, 1) == 1 {
+      w.WriteHeader(http.StatusServiceUnavailable)
+      return
+    }
+    fmt.Fprintf(w, `{"resources": {"statuses": {
+      "/statuses/show/:id": {"limit": 900, "remaining": 899, "reset": %d}
+    }}}`, time.Now().Add(time.Minute).Unix())
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  tc.warmUp = true
+  auth, _ := reserTweetRequest(nil)
+
+  se := tc.session(context.Background(), auth)
+  awaitWarmUp(t, se)
+  if limits := se.snapshot(); len(limits) != 0 {
+    t.Fatalf("got %d limits after failing to fetch them, expected 0", len(limits))
+  }
+  tc.session(context.Background(), auth)
+  awaitWarmUp(t, se)
+  if limits := se.snapshot(); len(limits) != 0 {
+    t.Fatalf("got %d limits before the retry interval passed, expected 0", len(limits))
+  }
+
+  // The context of the request which retries is already done, which must not stop the rate limits being fetched
+  se.warmUpMx.Lock()
+  se.warmUpRetry = time.Time{}
+  se.warmUpMx.Unlock()
+  ctx, cancel := context.WithCancel(context.Background())
+  cancel()
+  tc.session(ctx, auth)
+  awaitWarmUp(t, se)
+  if limits := se.snapshot(); len(limits) != 1 {
+    t.Fatalf("got %d limits after retrying, expected 1", len(limits))
+  }
+  tc.session(context.Background(), auth)
+  awaitWarmUp(t, se)
+  if n := atomic.LoadInt32(&requests); n != 2 {
+    t.Errorf("got %d rate limit status requests, expected 2", n)
+  }
+}
@@ -0,0 +1,114 @@
+package proxy
+
+import (
+  "context"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "google.golang.org/grpc/metadata"
+)
+
+func (p Proxy) GetList(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+  auth := desAuth(req.GetAuth())
+  query := reserListID(req.GetListId())
+  resp, meta, err := generateListResponse(func() (model.List, metadata.MD, error) {
+    var list model.List
+    if err := p.tc.standardRequest(ctx, showListEndpoint, auth, query, nil, &list); err != nil {
+      return model.List{}, nil, err
+    }
+    return list, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) GetListTimeline(ctx context.Context, req *pb.ListTimelineRequest) (*pb.TweetsResponse, error) {
+  auth := desAuth(req.GetAuth())
+  tlOpts := desTimelineOptions(req.GetTimelineOptions())
+  params := reserListTimelineParams(req)
+  resp, meta, err := generateTweetsResponse(func() (model.Timeline, metadata.MD, error) {
+    tweets, err := paginateTimeline(tlOpts, p.timelinePoller(ctx, listTimelineEndpoint, auth, params))
+    if err != nil {
+      return nil, nil, err
+    }
+    return tweets, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) GetListMembers(ctx context.Context, req *pb.ListMembersRequest) (*pb.UserPageResponse, error) {
+  auth := desAuth(req.GetAuth())
+  query := desCursorOptions(req.GetCursorOptions()).ser()
+  query.Extend(reserListMembersParams(req))
+  resp, meta, err := generateUserPageResponse(func() (model.UserPage, metadata.MD, error) {
+    var page model.UserPage
+    if err := p.tc.standardRequest(ctx, listMembersEndpoint, auth, query, nil, &page); err != nil {
+      return model.UserPage{}, nil, err
+    }
+    return page, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) AddListMember(ctx context.Context, req *pb.ListMemberRequest) (*pb.ListResponse, error) {
+  return p.changeListMember(ctx, addListMemberEndpoint, req)
+}
+
+func (p Proxy) RemoveListMember(ctx context.Context, req *pb.ListMemberRequest) (*pb.ListResponse, error) {
+  return p.changeListMember(ctx, removeListMemberEndpoint, req)
+}
+
+func (p Proxy) changeListMember(ctx context.Context, ep endpoint, req *pb.ListMemberRequest) (*pb.ListResponse, error) {
+  auth := desAuth(req.GetAuth())
+  query := reserListMemberParams(req)
+  resp, meta, err := generateListResponse(func() (model.List, metadata.MD, error) {
+    var list model.List
+    if err := p.tc.standardRequest(ctx, ep, auth, query, nil, &list); err != nil {
+      return model.List{}, nil, err
+    }
+    return list, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) GetOwnedLists(ctx context.Context, req *pb.OwnedListsRequest) (*pb.ListPageResponse, error) {
+  auth := desAuth(req.GetAuth())
+  query := desCursorOptions(req.GetCursorOptions()).ser()
+  query.Extend(reserOwnedListsParams(req))
+  resp, meta, err := generateListPageResponse(func() (model.ListPage, metadata.MD, error) {
+    var page model.ListPage
+    if err := p.tc.standardRequest(ctx, ownedListsEndpoint, auth, query, nil, &page); err != nil {
+      return model.ListPage{}, nil, err
+    }
+    return page, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
@@ -0,0 +1,99 @@
+package proxy
+
+import (
+  "context"
+  "crypto/rand"
+  "encoding/hex"
+  "github.com/sirupsen/logrus"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/metadata"
+  "google.golang.org/grpc/status"
+  "io/ioutil"
+  "time"
+)
+
+// Each RPC is given an id, which is attached to everything logged while handling it and sent back to the
+// client under this response metadata key. Clients may choose the id themselves by setting the same key in
+// their request metadata.
+const (
+  requestIDMetaKey   = "goldcrest-request-id"
+  maxRequestIDLength = 64
+)
+
+type loggerKey struct{}
+
+// Returns a logger which discards everything, for proxies created without one.
+func discardLogger() *logrus.Logger {
+  log := logrus.New()
+  log.SetOutput(ioutil.Discard)
+  return log
+}
+
+func withLogger(ctx context.Context, log logrus.FieldLogger) context.Context {
+  return context.WithValue(ctx, loggerKey{}, log)
+}
+
+// Returns the logger for the RPC being handled with ctx, or the client's own logger if ctx does not belong to
+// an RPC.
+func (tc twitterClient) logger(ctx context.Context) logrus.FieldLogger {
+  if log, ok := ctx.Value(loggerKey{}).(logrus.FieldLogger); ok {
+    return log
+  }
+  return tc.log
+}
+
+func requestID(ctx context.Context) string {
+  if meta, ok := metadata.FromIncomingContext(ctx); ok {
+    if vals := meta.Get(requestIDMetaKey); len(vals) > 0 && vals[0] != "" && len(vals[0]) <= maxRequestIDLength {
+      return vals[0]
+    }
+  }
+  var id [8]byte
+  if _, err := rand.Read(id[:]); err != nil {
+    return ""
+  }
+  return hex.EncodeToString(id[:])
+}
+
+type requestLogger struct {
+  log logrus.FieldLogger
+}
+
+// Gives the RPC a request id and a logger which includes it.
+func (rl requestLogger) start(ctx context.Context, method string) (context.Context, string, logrus.FieldLogger) {
+  id := requestID(ctx)
+  log := rl.log.WithFields(logrus.Fields{
+    "request_id": id,
+    "method":     method,
+  })
+  return withLogger(ctx, log), id, log
+}
+
+func logHandled(log logrus.FieldLogger, err error, d time.Duration) {
+  log.WithFields(logrus.Fields{
+    "code":     status.Code(err).String(),
+    "duration": d,
+  }).Debug("Handled request")
+}
+
+func (rl requestLogger) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+  start := time.Now()
+  ctx, id, log := rl.start(ctx, info.FullMethod)
+  if err := grpc.SetHeader(ctx, metadata.Pairs(requestIDMetaKey, id)); err != nil {
+    log.WithError(err).Warn("Failed to set request id header")
+  }
+  resp, err := handler(ctx, req)
+  logHandled(log, err, time.Since(start))
+  return resp, err
+}
+
+func (rl requestLogger) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+  start := time.Now()
+  ctx, id, log := rl.start(stream.Context(), info.FullMethod)
+  if err := stream.SetHeader(metadata.Pairs(requestIDMetaKey, id)); err != nil {
+    log.WithError(err).Warn("Failed to set request id header")
+  }
+  err := handler(srv, contextServerStream{ServerStream: stream, ctx: ctx})
+  logHandled(log, err, time.Since(start))
+  return err
+}
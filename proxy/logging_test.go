@@ -0,0 +1,72 @@
+package proxy
+
+import (
+  "context"
+  "github.com/sirupsen/logrus"
+  "github.com/sirupsen/logrus/hooks/test"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/metadata"
+  "testing"
+)
+
+type headerStream struct {
+  header metadata.MD
+}
+
+func (s *headerStream) Method() string {
+  return "/Twitter/GetTweet"
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+  s.header = metadata.Join(s.header, md)
+  return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+  return s.SetHeader(md)
+}
+
+func (s *headerStream) SetTrailer(md metadata.MD) error {
+  return nil
+}
+
+func TestRequestID(t *testing.T) {
+  log, hook := test.NewNullLogger()
+  log.SetLevel(logrus.DebugLevel)
+  rl := requestLogger{log: log}
+  tc := twitterClient{log: discardLogger()}
+  info := &grpc.UnaryServerInfo{FullMethod: "/Twitter/GetTweet"}
+  handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+    tc.logger(ctx).Info("Handling request")
+    return nil, nil
+  }
+
+  for _, incoming := range []string{"client-chosen-id", ""} {
+    hook.Reset()
+    stream := &headerStream{}
+    ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+    if incoming != "" {
+      ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(requestIDMetaKey, incoming))
+    }
+    if _, err := rl.unaryInterceptor(ctx, nil, info, handler); err != nil {
+      t.Fatalf("interceptor: %v", err)
+    }
+
+    ids := stream.header.Get(requestIDMetaKey)
+    if len(ids) != 1 || ids[0] == "" {
+      t.Fatalf("got request id header %v, expected a single id", ids)
+    }
+    if incoming != "" && ids[0] != incoming {
+      t.Errorf("got request id %q, expected %q", ids[0], incoming)
+    }
+    entries := hook.AllEntries()
+    if len(entries) != 2 {
+      t.Fatalf("got %d log entries, expected 2", len(entries))
+    }
+    for _, entry := range entries {
+      if entry.Data["request_id"] != ids[0] {
+        t.Errorf("%q logged with request id %v, expected %q", entry.Message, entry.Data["request_id"], ids[0])
+      }
+    }
+  }
+}
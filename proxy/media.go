@@ -0,0 +1,141 @@
+package proxy
+
+import (
+  "context"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "github.com/pantonshire/goldcrest/proxy/oauth"
+  "google.golang.org/grpc/codes"
+  "google.golang.org/grpc/metadata"
+  "google.golang.org/grpc/status"
+  "io"
+  "strconv"
+  "time"
+)
+
+const (
+  mediaMinCheckInterval = time.Second
+)
+
+func (p Proxy) UploadMedia(ctx context.Context, req *pb.UploadMediaRequest) (*pb.MediaResponse, error) {
+  auth, body, files := reserUploadMediaRequest(req)
+  resp, meta, err := generateMediaResponse(func() (model.UploadedMedia, metadata.MD, error) {
+    var media model.UploadedMedia
+    if err := p.tc.multipartRequest(ctx, mediaUploadEndpoint, auth, nil, body, files, decodeJSON(&media)); err != nil {
+      return model.UploadedMedia{}, nil, err
+    }
+    return media, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) UploadMediaChunked(stream pb.Twitter_UploadMediaChunkedServer) error {
+  ctx := stream.Context()
+
+  first, err := stream.Recv()
+  if err != nil {
+    return err
+  }
+  init := first.GetInit()
+  if init == nil {
+    return status.Error(codes.InvalidArgument, "first chunk of upload must be an init message")
+  }
+  auth, initParams := reserMediaInit(init)
+
+  resp, meta, err := generateMediaResponse(func() (model.UploadedMedia, metadata.MD, error) {
+    var media model.UploadedMedia
+    if err := p.tc.standardRequest(ctx, mediaUploadEndpoint, auth, nil, initParams, &media); err != nil {
+      return model.UploadedMedia{}, nil, err
+    }
+    mediaID := strconv.FormatUint(media.MediaID, 10)
+
+    for segment := 0; ; segment++ {
+      chunk, err := stream.Recv()
+      if err == io.EOF {
+        break
+      } else if err != nil {
+        return model.UploadedMedia{}, nil, err
+      }
+      data, ok := chunk.GetChunk().(*pb.MediaChunk_Data)
+      if !ok {
+        return model.UploadedMedia{}, nil, status.Error(codes.InvalidArgument, "only the first chunk of upload may be an init message")
+      }
+      params := oauth.NewParams()
+      params.Set("command", "APPEND")
+      params.Set("media_id", mediaID)
+      params.Set("segment_index", strconv.Itoa(segment))
+      files := []oauth.File{{Field: "media", Data: data.Data}}
+      // Twitter responds to APPEND with an empty body
+      if err := p.tc.multipartRequest(ctx, mediaUploadEndpoint, auth, nil, params, files, discardBody); err != nil {
+        return model.UploadedMedia{}, nil, err
+      }
+    }
+
+    params := oauth.NewParams()
+    params.Set("command", "FINALIZE")
+    params.Set("media_id", mediaID)
+    media = model.UploadedMedia{}
+    if err := p.tc.standardRequest(ctx, mediaUploadEndpoint, auth, nil, params, &media); err != nil {
+      return model.UploadedMedia{}, nil, err
+    }
+
+    if init.WaitForProcessing {
+      for media.Processing() {
+        wait := time.Duration(media.ProcessingInfo.CheckAfterSecs) * time.Second
+        if wait < mediaMinCheckInterval {
+          wait = mediaMinCheckInterval
+        }
+        if err := sleepContext(ctx, wait); err != nil {
+          return model.UploadedMedia{}, nil, err
+        }
+        if media, err = p.mediaStatus(ctx, auth, media.MediaID); err != nil {
+          return model.UploadedMedia{}, nil, err
+        }
+      }
+    }
+
+    return media, nil, nil
+  })
+  if err != nil {
+    return err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return err
+  }
+  return stream.SendAndClose(resp)
+}
+
+func (p Proxy) GetMediaStatus(ctx context.Context, req *pb.MediaStatusRequest) (*pb.MediaResponse, error) {
+  auth := desAuth(req.GetAuth())
+  resp, meta, err := generateMediaResponse(func() (model.UploadedMedia, metadata.MD, error) {
+    media, err := p.mediaStatus(ctx, auth, req.GetMediaId())
+    if err != nil {
+      return model.UploadedMedia{}, nil, err
+    }
+    return media, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) mediaStatus(ctx context.Context, auth oauth.AuthPair, mediaID uint64) (model.UploadedMedia, error) {
+  query := oauth.NewParams()
+  query.Set("command", "STATUS")
+  query.Set("media_id", strconv.FormatUint(mediaID, 10))
+  var media model.UploadedMedia
+  if err := p.tc.standardRequest(ctx, mediaStatusEndpoint, auth, query, nil, &media); err != nil {
+    return model.UploadedMedia{}, err
+  }
+  return media, nil
+}
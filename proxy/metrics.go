@@ -0,0 +1,163 @@
+package proxy
+
+import (
+  "context"
+  "crypto/sha256"
+  "encoding/hex"
+  "github.com/prometheus/client_golang/prometheus"
+  "github.com/prometheus/client_golang/prometheus/collectors"
+  "github.com/prometheus/client_golang/prometheus/promhttp"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/status"
+  "net/http"
+  "strconv"
+  "strings"
+  "time"
+)
+
+type metrics struct {
+  registry        *prometheus.Registry
+  rpcRequests     *prometheus.CounterVec
+  rpcDuration     *prometheus.HistogramVec
+  twitterRequests *prometheus.CounterVec
+  twitterDuration *prometheus.HistogramVec
+  rateLimitErrors *prometheus.CounterVec
+}
+
+func newMetrics(ses *sessions) *metrics {
+  m := &metrics{
+    registry: prometheus.NewRegistry(),
+    rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+      Name: "goldcrest_rpc_requests_total",
+      Help: "Number of gRPC requests handled, by method and status code.",
+    }, []string{"method", "code"}),
+    rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+      Name:    "goldcrest_rpc_duration_seconds",
+      Help:    "Time taken to handle gRPC requests, by method.",
+      Buckets: prometheus.DefBuckets,
+    }, []string{"method"}),
+    twitterRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+      Name: "goldcrest_twitter_requests_total",
+      Help: "Number of requests made to Twitter, by endpoint and HTTP status code.",
+    }, []string{"endpoint", "method", "status"}),
+    twitterDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+      Name:    "goldcrest_twitter_request_duration_seconds",
+      Help:    "Time taken for Twitter to respond, by endpoint.",
+      Buckets: prometheus.DefBuckets,
+    }, []string{"endpoint", "method"}),
+    rateLimitErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+      Name: "goldcrest_rate_limit_errors_total",
+      Help: "Number of requests refused because a rate limit was exhausted, by limit key.",
+    }, []string{"key"}),
+  }
+  m.registry.MustRegister(
+    m.rpcRequests,
+    m.rpcDuration,
+    m.twitterRequests,
+    m.twitterDuration,
+    m.rateLimitErrors,
+    limitCollector{ses: ses},
+    collectors.NewGoCollector(),
+    collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+  )
+  return m
+}
+
+func (m *metrics) handler() http.Handler {
+  return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *metrics) observeRPC(method string, err error, d time.Duration) {
+  m.rpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+  m.rpcDuration.WithLabelValues(method).Observe(d.Seconds())
+}
+
+// Does nothing if m is nil, so that twitterClients can be used without metrics.
+func (m *metrics) observeTwitterRequest(ep endpoint, resp *http.Response, d time.Duration) {
+  if m == nil {
+    return
+  }
+  statusLabel := "error"
+  if resp != nil {
+    statusLabel = strconv.Itoa(resp.StatusCode)
+  }
+  m.twitterRequests.WithLabelValues(ep.metricLabel(), ep.method.String(), statusLabel).Inc()
+  m.twitterDuration.WithLabelValues(ep.metricLabel(), ep.method.String()).Observe(d.Seconds())
+}
+
+func (m *metrics) observeRateLimitError(ep endpoint) {
+  if m == nil {
+    return
+  }
+  key := ep.limitKey()
+  if ep.raw {
+    key = ep.metricLabel()
+  }
+  m.rateLimitErrors.WithLabelValues(key).Inc()
+}
+
+func (m *metrics) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+  start := time.Now()
+  resp, err := handler(ctx, req)
+  m.observeRPC(info.FullMethod, err, time.Since(start))
+  return resp, err
+}
+
+func (m *metrics) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+  start := time.Now()
+  err := handler(srv, stream)
+  m.observeRPC(info.FullMethod, err, time.Since(start))
+  return err
+}
+
+// Reports the state of every tracked rate limit each time the metrics are scraped.
+type limitCollector struct {
+  ses *sessions
+}
+
+var (
+  limitRemainingDesc = prometheus.NewDesc(
+    "goldcrest_rate_limit_remaining",
+    "Number of requests left in the current rate limit window, by account and limit key.",
+    []string{"account", "key"}, nil,
+  )
+  limitResetDesc = prometheus.NewDesc(
+    "goldcrest_rate_limit_reset_seconds",
+    "Seconds until the current rate limit window ends, by account and limit key.",
+    []string{"account", "key"}, nil,
+  )
+)
+
+func (c limitCollector) Describe(ch chan<- *prometheus.Desc) {
+  ch <- limitRemainingDesc
+  ch <- limitResetDesc
+}
+
+func (c limitCollector) Collect(ch chan<- prometheus.Metric) {
+  now := time.Now()
+  for token, limits := range c.ses.snapshot() {
+    account := accountLabel(token)
+    for key, snapshot := range limits {
+      if snapshot.Current != nil {
+        ch <- prometheus.MustNewConstMetric(limitRemainingDesc, prometheus.GaugeValue, float64(*snapshot.Current), account, key)
+      }
+      if !snapshot.Resets.IsZero() {
+        reset := snapshot.Resets.Sub(now).Seconds()
+        if reset < 0 {
+          reset = 0
+        }
+        ch <- prometheus.MustNewConstMetric(limitResetDesc, prometheus.GaugeValue, reset, account, key)
+      }
+    }
+  }
+}
+
+// Access tokens should not appear in metrics, but they begin with the id of the account they belong to, which
+// can be used to tell them apart instead. Tokens which do not have this form are hashed.
+func accountLabel(token string) string {
+  if i := strings.IndexByte(token, '-'); i > 0 {
+    return token[:i]
+  }
+  hash := sha256.Sum256([]byte(token))
+  return hex.EncodeToString(hash[:4])
+}
@@ -0,0 +1,101 @@
+package proxy
+
+import (
+  "fmt"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "testing"
+  "time"
+)
+
+func TestLimitMetrics(t *testing.T) {
+  ses := newSessions(localLimits(true))
+  current, next := uint(12), uint(900)
+  resets := time.Now().Add(time.Minute)
+  ses.get("1234-abcd").getLimit(userTimelineEndpoint.limitKey()).finish(discardLogger(), &current, &next, &resets, false)
+
+  m := newMetrics(ses)
+  families, err := m.registry.Gather()
+  if err != nil {
+    t.Fatalf("gathering metrics: %v", err)
+  }
+
+  found := false
+  for _, family := range families {
+    if family.GetName() != "goldcrest_rate_limit_remaining" {
+      continue
+    }
+    for _, metric := range family.GetMetric() {
+      labels := make(map[string]string)
+      for _, label := range metric.GetLabel() {
+        labels[label.GetName()] = label.GetValue()
+      }
+      if labels["account"] != "1234" {
+        t.Errorf("got account label %q, expected %q", labels["account"], "1234")
+      }
+      if labels["key"] != userTimelineEndpoint.limitKey() {
+        t.Errorf("got key label %q, expected %q", labels["key"], userTimelineEndpoint.limitKey())
+      }
+      if val := metric.GetGauge().GetValue(); val != float64(current) {
+        t.Errorf("got remaining %v, expected %d", val, current)
+      }
+      found = true
+    }
+  }
+  if !found {
+    t.Errorf("no goldcrest_rate_limit_remaining metric")
+  }
+}
+
+func TestRawEndpointMetrics(t *testing.T) {
+  m := newMetrics(newSessions(localLimits(true)))
+  for _, path := range []string{"statuses/show.json", "anything/at/all.json"} {
+    _, ep, _, _ := reserRawAPIRequest(&pb.RawAPIRequest{Path: path})
+    m.observeTwitterRequest(ep, nil, time.Millisecond)
+  }
+
+  families, err := m.registry.Gather()
+  if err != nil {
+    t.Fatalf("gathering metrics: %v", err)
+  }
+  for _, family := range families {
+    if family.GetName() != "goldcrest_twitter_requests_total" {
+      continue
+    }
+    if len(family.GetMetric()) != 1 {
+      t.Fatalf("got %d series for raw requests, expected 1", len(family.GetMetric()))
+    }
+    for _, label := range family.GetMetric()[0].GetLabel() {
+      if label.GetName() == "endpoint" && label.GetValue() != "raw" {
+        t.Errorf("got endpoint label %q, expected %q", label.GetValue(), "raw")
+      }
+    }
+    return
+  }
+  t.Errorf("no goldcrest_twitter_requests_total metric")
+}
+
+func TestRawEndpointLimits(t *testing.T) {
+  ses := newSessions(localLimits(true))
+  se := ses.get("1234-abcd")
+  current, next := uint(12), uint(900)
+  resets := time.Now().Add(time.Minute)
+  for i := 0; i < maxRawLimits*2; i++ {
+    _, ep, _, _ := reserRawAPIRequest(&pb.RawAPIRequest{Path: fmt.Sprintf("made/up/%d.json", i)})
+    se.endpointLimit(ep).finish(discardLogger(), &current, &next, &resets, false)
+  }
+  // Raw requests to an endpoint the proxy uses share its limit, which is reported as usual
+  se.getLimit(userTimelineEndpoint.limitKey())
+  _, ep, _, _ := reserRawAPIRequest(&pb.RawAPIRequest{Path: userTimelineEndpoint.path})
+  se.endpointLimit(ep).finish(discardLogger(), &current, &next, &resets, false)
+
+  if len(se.limits) > maxRawLimits+1 {
+    t.Errorf("session kept %d limits, expected at most %d", len(se.limits), maxRawLimits+1)
+  }
+  limits := ses.snapshot()["1234-abcd"]
+  if len(limits) != 1 {
+    t.Fatalf("got %d limits in snapshot, expected only %s", len(limits), userTimelineEndpoint.limitKey())
+  }
+  if _, ok := limits[userTimelineEndpoint.limitKey()]; !ok {
+    t.Errorf("no limit for %s", userTimelineEndpoint.limitKey())
+  }
+}
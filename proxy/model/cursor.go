@@ -0,0 +1,18 @@
+package model
+
+type Cursors struct {
+  NextCursor        int64  `json:"next_cursor"`
+  NextCursorStr     string `json:"next_cursor_str"`
+  PreviousCursor    int64  `json:"previous_cursor"`
+  PreviousCursorStr string `json:"previous_cursor_str"`
+}
+
+type UserIDPage struct {
+  Cursors
+  IDs []uint64 `json:"ids"`
+}
+
+type UserPage struct {
+  Cursors
+  Users []User `json:"users"`
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+  "time"
+)
+
+const messageCreateEvent = "message_create"
+
+// Direct message events use strings for their ids and timestamps, which are decoded into numbers here.
+type DirectMessageEvent struct {
+  Type             string        `json:"type"`
+  ID               uint64        `json:"id,string"`
+  CreatedTimestamp int64         `json:"created_timestamp,string"`
+  MessageCreate    MessageCreate `json:"message_create"`
+}
+
+type MessageCreate struct {
+  Target struct {
+    RecipientID uint64 `json:"recipient_id,string"`
+  } `json:"target"`
+  SenderID    uint64      `json:"sender_id,string"`
+  SourceAppID string      `json:"source_app_id"`
+  MessageData MessageData `json:"message_data"`
+}
+
+type MessageData struct {
+  Text       string        `json:"text"`
+  Entities   TweetEntities `json:"entities"`
+  Attachment *struct {
+    Type  string `json:"type"`
+    Media Media  `json:"media"`
+  } `json:"attachment"`
+}
+
+func (event DirectMessageEvent) IsMessage() bool {
+  return event.Type == messageCreateEvent
+}
+
+func (event DirectMessageEvent) CreatedAt() time.Time {
+  return time.Unix(0, event.CreatedTimestamp*int64(time.Millisecond))
+}
+
+type DirectMessageEventResult struct {
+  Event DirectMessageEvent `json:"event"`
+}
+
+type DirectMessageEventList struct {
+  Events     []DirectMessageEvent `json:"events"`
+  NextCursor string               `json:"next_cursor"`
+}
+
+// The JSON body of a direct_messages/events/new request
+type NewDirectMessage struct {
+  Event struct {
+    Type          string `json:"type"`
+    MessageCreate struct {
+      Target struct {
+        RecipientID uint64 `json:"recipient_id,string"`
+      } `json:"target"`
+      MessageData struct {
+        Text       string             `json:"text"`
+        Attachment *MessageAttachment `json:"attachment,omitempty"`
+      } `json:"message_data"`
+    } `json:"message_create"`
+  } `json:"event"`
+}
+
+// Refers to previously uploaded media to attach to a new direct message
+type MessageAttachment struct {
+  Type  string `json:"type"`
+  Media struct {
+    ID uint64 `json:"id,string"`
+  } `json:"media"`
+}
+
+func NewDirectMessageEvent(recipientID uint64, text string, mediaID *uint64) NewDirectMessage {
+  var msg NewDirectMessage
+  msg.Event.Type = messageCreateEvent
+  msg.Event.MessageCreate.Target.RecipientID = recipientID
+  msg.Event.MessageCreate.MessageData.Text = text
+  if mediaID != nil {
+    attachment := MessageAttachment{Type: "media"}
+    attachment.Media.ID = *mediaID
+    msg.Event.MessageCreate.MessageData.Attachment = &attachment
+  }
+  return msg
+}
@@ -0,0 +1,13 @@
+package model
+
+// The body of an error response from Twitter. Most endpoints give a list of errors, but some (such as
+// media/upload) describe a single error with a string instead.
+type ErrorResponse struct {
+  Errors []ErrorDetail `json:"errors"`
+  Error  string        `json:"error"`
+}
+
+type ErrorDetail struct {
+  Code    uint32 `json:"code"`
+  Message string `json:"message"`
+}
@@ -0,0 +1,28 @@
+package model
+
+const privateListMode = "private"
+
+type List struct {
+  ID              uint64      `json:"id"`
+  IDStr           string      `json:"id_str"`
+  Name            string      `json:"name"`
+  Slug            string      `json:"slug"`
+  FullName        string      `json:"full_name"`
+  Description     string      `json:"description"`
+  URI             string      `json:"uri"`
+  CreatedAt       TwitterTime `json:"created_at"`
+  Mode            string      `json:"mode"`
+  MemberCount     uint32      `json:"member_count"`
+  SubscriberCount uint32      `json:"subscriber_count"`
+  Following       bool        `json:"following"`
+  User            User        `json:"user"`
+}
+
+func (list List) Private() bool {
+  return list.Mode == privateListMode
+}
+
+type ListPage struct {
+  Cursors
+  Lists []List `json:"lists"`
+}
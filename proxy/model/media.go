@@ -0,0 +1,26 @@
+package model
+
+type UploadedMedia struct {
+  MediaID          uint64          `json:"media_id"`
+  MediaIDStr       string          `json:"media_id_string"`
+  Size             uint64          `json:"size"`
+  ExpiresAfterSecs int64           `json:"expires_after_secs"`
+  ProcessingInfo   *ProcessingInfo `json:"processing_info"`
+}
+
+type ProcessingInfo struct {
+  State           string `json:"state"`
+  CheckAfterSecs  int64  `json:"check_after_secs"`
+  ProgressPercent uint32 `json:"progress_percent"`
+
+  Error *struct {
+    Code    int    `json:"code"`
+    Name    string `json:"name"`
+    Message string `json:"message"`
+  } `json:"error"`
+}
+
+// Returns true if Twitter has not yet finished processing the media.
+func (media UploadedMedia) Processing() bool {
+  return media.ProcessingInfo != nil && (media.ProcessingInfo.State == "pending" || media.ProcessingInfo.State == "in_progress")
+}
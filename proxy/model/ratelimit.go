@@ -0,0 +1,13 @@
+package model
+
+// The response of application/rate_limit_status. Resources maps families of endpoints (e.g. "statuses") to
+// the limits of the endpoints in them, which are keyed by path (e.g. "/statuses/show/:id").
+type RateLimitStatus struct {
+  Resources map[string]map[string]RateLimitResource `json:"resources"`
+}
+
+type RateLimitResource struct {
+  Limit     uint  `json:"limit"`
+  Remaining uint  `json:"remaining"`
+  Reset     int64 `json:"reset"`
+}
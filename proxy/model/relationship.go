@@ -0,0 +1,62 @@
+package model
+
+// Blocking and Muting are nil if the response the relationship was built from does not say.
+type Relationship struct {
+  ID                   uint64
+  ScreenName           string
+  User                 *User
+  Following            bool
+  FollowingRequested   bool
+  Blocking             *bool
+  Muting               *bool
+  NotificationsEnabled bool
+  FollowedBy           bool
+  CanDM                bool
+}
+
+// The response from friendships/show
+type Friendship struct {
+  Rel struct {
+    Source struct {
+      Following            bool `json:"following"`
+      FollowingRequested   bool `json:"following_requested"`
+      FollowedBy           bool `json:"followed_by"`
+      Blocking             bool `json:"blocking"`
+      Muting               bool `json:"muting"`
+      NotificationsEnabled bool `json:"notifications_enabled"`
+      CanDM                bool `json:"can_dm"`
+    } `json:"source"`
+    Target struct {
+      ID         uint64 `json:"id"`
+      ScreenName string `json:"screen_name"`
+    } `json:"target"`
+  } `json:"relationship"`
+}
+
+func (f Friendship) Relationship() Relationship {
+  src := f.Rel.Source
+  return Relationship{
+    ID:                   f.Rel.Target.ID,
+    ScreenName:           f.Rel.Target.ScreenName,
+    Following:            src.Following,
+    FollowingRequested:   src.FollowingRequested,
+    Blocking:             &src.Blocking,
+    Muting:               &src.Muting,
+    NotificationsEnabled: src.NotificationsEnabled,
+    FollowedBy:           src.FollowedBy,
+    CanDM:                src.CanDM,
+  }
+}
+
+// The relationship reported by a user object, which does not say whether the user follows the authenticated
+// user, can be sent direct messages by them, or is blocked or muted by them.
+func (user User) Relationship() Relationship {
+  return Relationship{
+    ID:                   user.ID,
+    ScreenName:           user.ScreenName,
+    User:                 &user,
+    Following:            user.Following,
+    FollowingRequested:   user.FollowRequestSent,
+    NotificationsEnabled: user.Notifications,
+  }
+}
@@ -1,15 +1,40 @@
 package model
 
+import (
+  "net/url"
+  "strconv"
+  "strings"
+)
+
 type SearchResult struct {
-  Statuses Timeline `json:"statuses"`
-  Meta     struct {
-    CompletedIn float32 `json:"completed_in"`
-    MaxID       uint64  `json:"max_id"`
-    MaxIDStr    string  `json:"max_id_str"`
-    NextResults string  `json:"next_results"`
-    Query       string  `json:"query"`
-    Count       uint    `json:"count"`
-    SinceID     uint64  `json:"since_id"`
-    SinceIDStr  string  `json:"since_id_str"`
-  } `json:"search_metadata"`
+  Statuses Timeline       `json:"statuses"`
+  Meta     SearchMetadata `json:"search_metadata"`
+}
+
+type SearchMetadata struct {
+  CompletedIn float64 `json:"completed_in"`
+  MaxID       uint64  `json:"max_id"`
+  MaxIDStr    string  `json:"max_id_str"`
+  NextResults string  `json:"next_results"`
+  RefreshURL  string  `json:"refresh_url"`
+  Query       string  `json:"query"`
+  Count       uint    `json:"count"`
+  SinceID     uint64  `json:"since_id"`
+  SinceIDStr  string  `json:"since_id_str"`
+}
+
+// Returns the max_id given in the next_results query string, if there is one.
+func (meta SearchMetadata) NextMaxID() (uint64, bool) {
+  if meta.NextResults == "" {
+    return 0, false
+  }
+  query, err := url.ParseQuery(strings.TrimPrefix(meta.NextResults, "?"))
+  if err != nil {
+    return 0, false
+  }
+  maxID, err := strconv.ParseUint(query.Get("max_id"), 10, 64)
+  if err != nil {
+    return 0, false
+  }
+  return maxID, true
 }
@@ -24,6 +24,11 @@ type User struct {
   WithheldScope       string       `json:"withheld_scope"`
   Entities            UserEntities `json:"entities"`
 
+  // Describe the authenticated user's relationship with this user
+  Following         bool `json:"following"`
+  FollowRequestSent bool `json:"follow_request_sent"`
+  Notifications     bool `json:"notifications"`
+
   //TODO: derived
 }
 
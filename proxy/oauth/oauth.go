@@ -8,6 +8,7 @@ import (
   "encoding/base64"
   "fmt"
   "github.com/martinlindhe/base36"
+  "mime/multipart"
   "net/http"
   "path"
   "strings"
@@ -33,6 +34,18 @@ type AuthPair struct {
 type Request struct {
   Method, Protocol, Domain, Path string
   Query, Body                    Params
+  // If there are any files, the body is sent as multipart/form-data rather than
+  // application/x-www-form-urlencoded, and the body parameters are not included in the signature.
+  Files []File
+  // If set, the body is sent as application/json instead, and the body parameters are ignored. As with
+  // multipart bodies, the JSON is not included in the signature.
+  JSON []byte
+}
+
+// A file to be sent in a multipart/form-data request body.
+type File struct {
+  Field string
+  Data  []byte
 }
 
 func NewRequest(method, protocol, domain, path string, query, body Params) Request {
@@ -46,6 +59,18 @@ func NewRequest(method, protocol, domain, path string, query, body Params) Reque
   }
 }
 
+func NewJSONRequest(method, protocol, domain, path string, query Params, json []byte) Request {
+  req := NewRequest(method, protocol, domain, path, query, nil)
+  req.JSON = json
+  return req
+}
+
+func NewMultipartRequest(method, protocol, domain, path string, query, body Params, files []File) Request {
+  req := NewRequest(method, protocol, domain, path, query, body)
+  req.Files = files
+  return req
+}
+
 // Creates a new http.Request containing an authentication header as described at
 // https://developer.twitter.com/en/docs/authentication/oauth-1-0a/authorizing-a-request
 func (or Request) MakeRequest(auth AuthPair) (*http.Request, error) {
@@ -68,27 +93,72 @@ func (or Request) MakeRequest(auth AuthPair) (*http.Request, error) {
   oauthParams.set("oauth_timestamp", timestamp)
   oauthParams.set("oauth_nonce", nonce)
 
+  var (
+    body        *bytes.Buffer
+    contentType string
+  )
+
+  if or.JSON != nil {
+    body = bytes.NewBuffer(or.JSON)
+    contentType = "application/json"
+    bodyParams = percentEncodedParams{}
+  } else if len(or.Files) > 0 {
+    // Parameters in a multipart body are not part of the signature base string
+    body, contentType, err = multipartBody(or.Body, or.Files)
+    if err != nil {
+      return nil, err
+    }
+    bodyParams = percentEncodedParams{}
+  } else {
+    body = bytes.NewBufferString(bodyParams.encode("&", false))
+    if body.Len() > 0 {
+      contentType = "application/x-www-form-urlencoded"
+    }
+  }
+
   signature := signOAuth(auth.Secret, or.Method, baseURL, oauthParams, queryParams, bodyParams)
   oauthParams.set("oauth_signature", signature)
 
   authorization := "OAuth " + oauthParams.encode(", ", true)
 
   fullURL := baseURL + "?" + queryParams.encode("&", false)
-  bodyStr := bodyParams.encode("&", false)
 
-  req, err := http.NewRequest(or.Method, fullURL, bytes.NewBufferString(bodyStr))
+  req, err := http.NewRequest(or.Method, fullURL, body)
   if err != nil {
     return nil, err
   }
 
-  if bodyStr != "" {
-    req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+  if contentType != "" {
+    req.Header.Set("Content-Type", contentType)
   }
   req.Header.Set("Authorization", authorization)
 
   return req, nil
 }
 
+func multipartBody(params Params, files []File) (*bytes.Buffer, string, error) {
+  var body bytes.Buffer
+  writer := multipart.NewWriter(&body)
+  for key, val := range params {
+    if err := writer.WriteField(key, val); err != nil {
+      return nil, "", err
+    }
+  }
+  for _, file := range files {
+    part, err := writer.CreateFormFile(file.Field, file.Field)
+    if err != nil {
+      return nil, "", err
+    }
+    if _, err := part.Write(file.Data); err != nil {
+      return nil, "", err
+    }
+  }
+  if err := writer.Close(); err != nil {
+    return nil, "", err
+  }
+  return &body, writer.FormDataContentType(), nil
+}
+
 // Creates an OAuth signature using the method described at
 // https://developer.twitter.com/en/docs/authentication/oauth-1-0a/creating-a-signature
 func signOAuth(secret Auth, method, baseURL string, oauthParams, queryParams, bodyParams percentEncodedParams) string {
@@ -0,0 +1,68 @@
+package oauth
+
+import (
+  "io/ioutil"
+  "mime"
+  "mime/multipart"
+  "testing"
+)
+
+func TestMultipartRequest(t *testing.T) {
+  body := NewParams()
+  body.Set("command", "APPEND")
+  files := []File{{Field: "media", Data: []byte{0x00, 0xFF, 0x10}}}
+  req, err := NewMultipartRequest("POST", "https", "upload.twitter.com/1.1", "media/upload.json", nil, body, files).
+    MakeRequest(AuthPair{})
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+  if err != nil {
+    t.Fatal(err)
+  }
+  if mediaType != "multipart/form-data" {
+    t.Fatalf("got content type \"%s\", expected multipart/form-data", mediaType)
+  }
+
+  form, err := multipart.NewReader(req.Body, params["boundary"]).ReadForm(1 << 20)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if command := form.Value["command"]; len(command) != 1 || command[0] != "APPEND" {
+    t.Errorf("got command %v, expected [APPEND]", command)
+  }
+  if len(form.File["media"]) != 1 {
+    t.Fatalf("got %d media files, expected 1", len(form.File["media"]))
+  }
+  file, err := form.File["media"][0].Open()
+  if err != nil {
+    t.Fatal(err)
+  }
+  data, err := ioutil.ReadAll(file)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if string(data) != string(files[0].Data) {
+    t.Errorf("got media %v, expected %v", data, files[0].Data)
+  }
+}
+
+func TestJSONRequest(t *testing.T) {
+  body := []byte(`{"event":{"type":"message_create"}}`)
+  req, err := NewJSONRequest("POST", "https", "api.twitter.com/1.1", "direct_messages/events/new.json", nil, body).
+    MakeRequest(AuthPair{})
+  if err != nil {
+    t.Fatal(err)
+  }
+  if contentType := req.Header.Get("Content-Type"); contentType != "application/json" {
+    t.Fatalf("got content type \"%s\", expected application/json", contentType)
+  }
+  data, err := ioutil.ReadAll(req.Body)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if string(data) != string(body) {
+    t.Errorf("got body %s, expected %s", data, body)
+  }
+}
@@ -26,6 +26,11 @@ func (ps Params) Extend(other Params) {
   }
 }
 
+// Encodes the parameters as a query string, with the keys in sorted order.
+func (ps Params) Encode() string {
+  return percentEncodedParams(ps).encode("&", false)
+}
+
 func (pp percentEncodedParams) set(key, val string) bool {
   return Params(pp).Set(key, val)
 }
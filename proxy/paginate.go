@@ -0,0 +1,55 @@
+package proxy
+
+import (
+  "github.com/pantonshire/goldcrest/proxy/model"
+)
+
+// Fetches successive pages of a timeline, each one older than the last, until maxPages pages or totalCount
+// tweets have been fetched, or until the timeline is exhausted. If the rate limit runs out after the first
+// page, the tweets fetched so far are returned rather than an error. Tweets which appear on more than one
+// page are only included once.
+func paginateTimeline(opts timelineOptions, fetch tweetPoller) (model.Timeline, error) {
+  if opts.maxPages <= 1 && opts.totalCount == 0 {
+    return fetch(opts)
+  }
+
+  var (
+    tweets model.Timeline
+    seen   = make(map[uint64]bool)
+  )
+
+  for page := uint(0); opts.maxPages == 0 || page < opts.maxPages; page++ {
+    pageTweets, err := fetch(opts)
+    if err != nil {
+      if _, ok := err.(rateLimitError); ok && page > 0 {
+        break
+      }
+      return nil, err
+    }
+
+    var added int
+    oldest := ^uint64(0)
+    for _, tweet := range pageTweets {
+      if tweet.ID < oldest {
+        oldest = tweet.ID
+      }
+      if !seen[tweet.ID] {
+        seen[tweet.ID] = true
+        tweets = append(tweets, tweet)
+        added++
+      }
+    }
+
+    if opts.totalCount > 0 && uint(len(tweets)) >= opts.totalCount {
+      return tweets[:opts.totalCount], nil
+    }
+    if added == 0 || oldest == 0 {
+      break
+    }
+
+    opts.maxID = new(uint64)
+    *opts.maxID = oldest - 1
+  }
+
+  return tweets, nil
+}
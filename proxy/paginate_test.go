@@ -0,0 +1,75 @@
+package proxy
+
+import (
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "testing"
+  "time"
+)
+
+// Serves a timeline of tweets with ids from 1 to n, newest first, a page at a time. After limit pages, it
+// responds with a rate limit error.
+func fakeTimeline(n uint64, limit int) (tweetPoller, *int) {
+  var calls int
+  return func(opts timelineOptions) (model.Timeline, error) {
+    calls++
+    if calls > limit {
+      return nil, newRateLimitError(time.Time{})
+    }
+    id := n
+    if opts.maxID != nil && *opts.maxID < id {
+      id = *opts.maxID
+    }
+    var tweets model.Timeline
+    // Repeat the newest tweet of the previous page to check that duplicates are removed
+    if opts.maxID != nil && id < n {
+      tweets = append(tweets, model.Tweet{ID: id + 1})
+    }
+    for ; id > 0 && uint(len(tweets)) < opts.count; id-- {
+      tweets = append(tweets, model.Tweet{ID: id})
+    }
+    return tweets, nil
+  }, &calls
+}
+
+func TestPaginateTimeline(t *testing.T) {
+  fetch, calls := fakeTimeline(10, 100)
+  tweets, err := paginateTimeline(timelineOptions{count: 3, maxPages: 2}, fetch)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if *calls != 2 || len(tweets) != 5 || tweets[4].ID != 6 {
+    t.Errorf("got %d tweets from %d pages, expected 5 from 2", len(tweets), *calls)
+  }
+
+  fetch, _ = fakeTimeline(10, 100)
+  tweets, err = paginateTimeline(timelineOptions{count: 4, totalCount: 20}, fetch)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if len(tweets) != 10 {
+    t.Errorf("got %d tweets from exhausted timeline, expected 10", len(tweets))
+  }
+
+  fetch, _ = fakeTimeline(10, 100)
+  tweets, err = paginateTimeline(timelineOptions{count: 4, totalCount: 6}, fetch)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if len(tweets) != 6 {
+    t.Errorf("got %d tweets, expected total count of 6", len(tweets))
+  }
+
+  fetch, _ = fakeTimeline(10, 2)
+  tweets, err = paginateTimeline(timelineOptions{count: 3, maxPages: 5}, fetch)
+  if err != nil {
+    t.Fatalf("rate limit after first page: %v", err)
+  }
+  if len(tweets) != 5 {
+    t.Errorf("got %d tweets before rate limit, expected 5", len(tweets))
+  }
+
+  fetch, _ = fakeTimeline(10, 0)
+  if _, err := paginateTimeline(timelineOptions{count: 3, maxPages: 5}, fetch); err == nil {
+    t.Error("expected rate limit error on first page")
+  }
+}
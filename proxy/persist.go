@@ -0,0 +1,107 @@
+package proxy
+
+import (
+  "crypto/sha256"
+  "encoding/hex"
+  "encoding/json"
+  "github.com/sirupsen/logrus"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "time"
+)
+
+// The state of a single rate limit, as saved by a LimitStore.
+type LimitSnapshot struct {
+  Current *uint     `json:"current,omitempty"`
+  Next    *uint     `json:"next,omitempty"`
+  Resets  time.Time `json:"resets"`
+}
+
+// Rate limit snapshots, keyed by a hash of the access token (see tokenDigest) and then by limit key.
+type LimitSnapshots map[string]map[string]LimitSnapshot
+
+// Returns a hash of the access token, which identifies the token's rate limits in the saved state and in Redis
+// without storing the token itself.
+func tokenDigest(token string) string {
+  hash := sha256.Sum256([]byte(token))
+  return hex.EncodeToString(hash[:])
+}
+
+// A LimitStore persists rate limit state so that it is not lost when the proxy restarts.
+type LimitStore interface {
+  Load() (LimitSnapshots, error)
+  Save(snapshots LimitSnapshots) error
+}
+
+// A LimitStore which saves rate limit state to a JSON file.
+type FileLimitStore struct {
+  path string
+}
+
+func NewFileLimitStore(path string) FileLimitStore {
+  return FileLimitStore{path: path}
+}
+
+func (store FileLimitStore) Load() (LimitSnapshots, error) {
+  data, err := ioutil.ReadFile(store.path)
+  if err != nil {
+    if os.IsNotExist(err) {
+      return LimitSnapshots{}, nil
+    }
+    return nil, err
+  }
+  var snapshots LimitSnapshots
+  if err := json.Unmarshal(data, &snapshots); err != nil {
+    return nil, err
+  }
+  return snapshots, nil
+}
+
+// Writes to a temporary file first and then renames it, so that the existing file is never left half-written.
+func (store FileLimitStore) Save(snapshots LimitSnapshots) error {
+  data, err := json.Marshal(snapshots)
+  if err != nil {
+    return err
+  }
+  tmp, err := ioutil.TempFile(filepath.Dir(store.path), filepath.Base(store.path)+".tmp")
+  if err != nil {
+    return err
+  }
+  defer os.Remove(tmp.Name())
+  if _, err := tmp.Write(data); err != nil {
+    tmp.Close()
+    return err
+  }
+  if err := tmp.Close(); err != nil {
+    return err
+  }
+  return os.Rename(tmp.Name(), store.path)
+}
+
+// Periodically saves the rate limit state to the store until stop is closed, at which point the state is
+// saved one final time.
+func persistLimits(ses *sessions, store LimitStore, interval time.Duration, log logrus.FieldLogger, stop <-chan struct{}, done chan<- struct{}) {
+  defer close(done)
+
+  var tick <-chan time.Time
+  if interval > 0 {
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+    tick = ticker.C
+  }
+
+  for {
+    select {
+    case <-tick:
+      if err := store.Save(ses.persistSnapshot()); err != nil {
+        log.WithError(err).Error("Failed to save rate limits")
+      }
+    case <-stop:
+      if err := store.Save(ses.persistSnapshot()); err != nil {
+        log.WithError(err).Error("Failed to save rate limits")
+      }
+      return
+    }
+  }
+}
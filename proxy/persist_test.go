@@ -0,0 +1,61 @@
+package proxy
+
+import (
+  "path/filepath"
+  "testing"
+  "time"
+)
+
+func TestFileLimitStore(t *testing.T) {
+  store := NewFileLimitStore(filepath.Join(t.TempDir(), "limits.json"))
+
+  loaded, err := store.Load()
+  if err != nil {
+    t.Fatalf("loading missing file: %v", err)
+  }
+  if len(loaded) != 0 {
+    t.Fatalf("got %d tokens from missing file, expected 0", len(loaded))
+  }
+
+  current, next := uint(3), uint(15)
+  resets := time.Unix(1600000000, 0)
+  ses := newSessions(localLimits(true))
+  ses.get("token").getLimit("singleton:statuses/show.json").finish(discardLogger(), &current, &next, &resets, false)
+  ses.get("token").getLimit("singleton:search/tweets.json")
+
+  if err := store.Save(ses.persistSnapshot()); err != nil {
+    t.Fatalf("saving: %v", err)
+  }
+  loaded, err = store.Load()
+  if err != nil {
+    t.Fatalf("loading: %v", err)
+  }
+  if _, ok := loaded["token"]; ok || len(loaded) != 1 {
+    t.Fatalf("got tokens %v, expected only the token's digest", loaded)
+  }
+
+  // The saved limits have already reset, so they are dropped if the token is not seen again
+  unused := newSessions(localLimits(true))
+  unused.restore(loaded)
+  if snapshots := unused.persistSnapshot(); len(snapshots) != 0 {
+    t.Errorf("got %d tokens, expected the saved limits to be dropped", len(snapshots))
+  }
+
+  restored := newSessions(localLimits(true))
+  restored.restore(loaded)
+  restored.get("token")
+  snapshots := restored.snapshot()
+  if len(snapshots["token"]) != 1 {
+    t.Fatalf("got %d limits, expected 1", len(snapshots["token"]))
+  }
+  snapshot := snapshots["token"]["singleton:statuses/show.json"]
+  if snapshot.Current == nil || *snapshot.Current != current {
+    t.Errorf("got current %v, expected %d", snapshot.Current, current)
+  }
+  if snapshot.Next == nil || *snapshot.Next != next {
+    t.Errorf("got next %v, expected %d", snapshot.Next, next)
+  }
+  if !snapshot.Resets.Equal(resets) {
+    t.Errorf("got resets %s, expected %s", snapshot.Resets, resets)
+  }
+}
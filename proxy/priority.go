@@ -0,0 +1,37 @@
+package proxy
+
+import (
+  "context"
+  "google.golang.org/grpc/metadata"
+)
+
+// Clients set this metadata key to "background" for requests which can be held back to leave some of the
+// rate limit for interactive requests. Requests without it are treated as interactive.
+const priorityMetaKey = "goldcrest-priority"
+
+type priority int
+
+const (
+  interactivePriority priority = iota
+  backgroundPriority
+)
+
+func requestPriority(ctx context.Context) priority {
+  meta, ok := metadata.FromIncomingContext(ctx)
+  if !ok {
+    return interactivePriority
+  }
+  if vals := meta.Get(priorityMetaKey); len(vals) > 0 && vals[0] == "background" {
+    return backgroundPriority
+  }
+  return interactivePriority
+}
+
+// Returns the number of units of the endpoint's rate limit which must be left over for interactive requests
+// after this request.
+func (tc twitterClient) reserve(ctx context.Context, ep endpoint) uint {
+  if requestPriority(ctx) != backgroundPriority {
+    return 0
+  }
+  return tc.reserves[ep.limitName()]
+}
@@ -2,37 +2,210 @@ package proxy
 
 import (
   "context"
+  "fmt"
   pb "github.com/pantonshire/goldcrest/protocol"
   "github.com/pantonshire/goldcrest/proxy/model"
   "github.com/pantonshire/goldcrest/proxy/oauth"
   "github.com/sirupsen/logrus"
+  "go.opentelemetry.io/otel/trace"
   "google.golang.org/grpc"
   "google.golang.org/grpc/metadata"
+  "io/ioutil"
+  "net/http"
   "strconv"
   "time"
 )
 
-var log *logrus.Logger
+const (
+  // The base URL used for uploading media if the Config does not give one
+  defaultTwitterUploadURL = "upload.twitter.com/1.1"
+  // The most users users/lookup accepts at once
+  maxUserLookup = 100
+)
 
 type Proxy struct {
-  tc twitterClient
+  tc          twitterClient
+  metrics     *metrics
+  log         *logrus.Logger
+  statusErrs  statusErrors
+  // Nil if GetTweet requests are not batched
+  batcher     *tweetBatcher
+  persistStop chan struct{}
+  persistDone chan struct{}
+  // Cancelled by EndStreams, since streams would otherwise only end when their clients go away
+  streams    context.Context
+  endStreams context.CancelFunc
+}
+
+type Config struct {
+  TwitterTimeout   time.Duration
+  TwitterProtocol  string
+  TwitterURL       string
+  // The base URL used for uploading media. If empty, upload.twitter.com/1.1 is used.
+  TwitterUploadURL string
+  AssumeNextLimit  bool
+
+  // If set, rate limit state is loaded from the store when the proxy is created and saved to it
+  // periodically, so that it survives restarts.
+  LimitStore LimitStore
+  // How often to save rate limit state to the LimitStore. If zero, it is only saved when the proxy is closed.
+  LimitSaveInterval time.Duration
+  // If set, rate limit state is kept in the store rather than in memory, so that it can be shared between
+  // several instances of the proxy.
+  SharedLimitStore SharedLimitStore
+
+  // How long responses to GetTweet and GetTweets are cached for. Caching is disabled if this or CacheSize is
+  // zero.
+  CacheTTL time.Duration
+  // The maximum number of responses to keep in the cache.
+  CacheSize int
+
+  // If non-zero, GetTweet requests made with the same credentials within this window of each other are sent
+  // to Twitter together as a single statuses/lookup request.
+  BatchWindow time.Duration
+
+  // The number of units of each rate limit to hold back for interactive requests, so that background
+  // requests cannot use up the whole limit. Keys are limit group names (e.g. "publish") or, for endpoints
+  // which are not in a group, endpoint paths (e.g. "statuses/show.json").
+  LimitReserves map[string]uint
+  // If true, the rate limits for each access token are fetched from application/rate_limit_status before its
+  // first request is made.
+  WarmUpLimits bool
+
+  // Clients which are only allowed to use part of each rate limit.
+  Clients []ClientIdentity
+
+  // If set, spans are recorded for each RPC and for the rate limiting, signing and sending of the requests made
+  // to Twitter while handling it.
+  TracerProvider trace.TracerProvider
+
+  // If true, errors are returned as gRPC statuses rather than as responses containing an Error, unless the
+  // client asks otherwise with the goldcrest-errors metadata.
+  StatusErrors bool
+
+  // The most times to retry a request to Twitter which fails because of a connection error or a 5xx response.
+  // Only GET requests are retried, except after connection errors which happened before any of the request was
+  // sent. Each retry uses another unit of the rate limit. Zero, the default, disables retrying.
+  UpstreamRetries uint
+  // The delay before the first retry. The delay doubles with each retry, up to UpstreamMaxBackoff, and a random
+  // amount of up to the delay is actually waited.
+  UpstreamBackoff    time.Duration
+  UpstreamMaxBackoff time.Duration
+}
+
+// Creates a proxy which logs to logger. If logger is nil, nothing is logged.
+func NewProxy(logger *logrus.Logger, conf Config) (*Proxy, error) {
+  if logger == nil {
+    logger = discardLogger()
+  }
+  newLimit := localLimits(conf.AssumeNextLimit)
+  if conf.SharedLimitStore != nil {
+    newLimit = sharedLimits(conf.SharedLimitStore, conf.AssumeNextLimit, logger)
+  }
+  p := &Proxy{
+    tc:         newTwitterClient(conf.TwitterTimeout, conf.TwitterProtocol, conf.TwitterURL, strAlt(conf.TwitterUploadURL, defaultTwitterUploadURL), newLimit),
+    log:        logger,
+    statusErrs: statusErrors{byDefault: conf.StatusErrors},
+  }
+  p.streams, p.endStreams = context.WithCancel(context.Background())
+  p.tc.log = logger
+  if conf.CacheTTL > 0 && conf.CacheSize > 0 {
+    p.tc.cache = newResponseCache(conf.CacheTTL, conf.CacheSize)
+  }
+  p.metrics = newMetrics(p.tc.ses)
+  p.tc.metrics = p.metrics
+  if conf.TracerProvider != nil {
+    p.tc.tracer = conf.TracerProvider.Tracer(tracerName)
+  }
+  p.tc.reserves = conf.LimitReserves
+  p.tc.warmUp = conf.WarmUpLimits
+  p.tc.retry = retryPolicy{
+    retries:    conf.UpstreamRetries,
+    backoff:    conf.UpstreamBackoff,
+    maxBackoff: conf.UpstreamMaxBackoff,
+  }
+  if len(conf.Clients) > 0 {
+    p.tc.quotas = newQuotas(conf.Clients)
+  }
+  if conf.BatchWindow > 0 {
+    p.batcher = newTweetBatcher(p.tc, conf.BatchWindow)
+  }
+  if conf.LimitStore != nil {
+    snapshots, err := conf.LimitStore.Load()
+    if err != nil {
+      return nil, err
+    }
+    p.tc.ses.restore(snapshots)
+    logger.WithField("tokens", len(snapshots)).Info("Loaded saved rate limits")
+    p.persistStop, p.persistDone = make(chan struct{}), make(chan struct{})
+    go persistLimits(p.tc.ses, conf.LimitStore, conf.LimitSaveInterval, logger, p.persistStop, p.persistDone)
+  }
+  return p, nil
+}
+
+// Returns the options which the gRPC server the proxy is registered with should be created with, so that the
+// proxy can give each request an id, record logs, metrics and traces for the requests it handles and report
+// errors as gRPC statuses.
+func (p *Proxy) ServerOptions() []grpc.ServerOption {
+  rl := requestLogger{log: p.log}
+  return []grpc.ServerOption{
+    grpc.ChainUnaryInterceptor(
+      rl.unaryInterceptor,
+      tracingUnaryInterceptor(p.tc.tracer),
+      p.metrics.unaryInterceptor,
+      p.statusErrs.unaryInterceptor,
+    ),
+    grpc.ChainStreamInterceptor(
+      rl.streamInterceptor,
+      tracingStreamInterceptor(p.tc.tracer),
+      p.metrics.streamInterceptor,
+      p.statusErrs.streamInterceptor,
+    ),
+  }
+}
+
+// Returns a handler which serves the proxy's metrics in the Prometheus exposition format.
+func (p *Proxy) MetricsHandler() http.Handler {
+  return p.metrics.handler()
+}
+
+// Ends every open stream with an UNAVAILABLE status, so that the gRPC server can stop gracefully without
+// waiting for clients to close their streams. Requests which are not streams are left to finish.
+func (p *Proxy) EndStreams() {
+  p.endStreams()
 }
 
-func NewProxy(logger *logrus.Logger, twitterTimeout time.Duration, twitterProtocol, twitterURL string, assumeNextLimit bool) *Proxy {
-  log = logger
-  return &Proxy{
-    tc: newTwitterClient(twitterTimeout, twitterProtocol, twitterURL, assumeNextLimit),
+// Ends any open streams and releases any resources held by the proxy. If a LimitStore was provided, the rate
+// limit state is saved to it one last time before Close returns.
+func (p *Proxy) Close() {
+  p.EndStreams()
+  if p.persistStop != nil {
+    close(p.persistStop)
+    <-p.persistDone
+    p.persistStop = nil
   }
 }
 
 func (p Proxy) GetTweet(ctx context.Context, req *pb.TweetRequest) (*pb.TweetResponse, error) {
   auth, query := reserTweetRequest(req)
   resp, meta, err := generateTweetResponse(func() (model.Tweet, metadata.MD, error) {
-    var tweet model.Tweet
-    if err := p.tc.standardRequest(showTweetEndpoint, auth, query, nil, &tweet); err != nil {
+    var (
+      tweet model.Tweet
+      meta  metadata.MD
+      err   error
+      tags  = []string{tweetCacheTag(req.GetId())}
+    )
+    if p.batcher != nil {
+      meta, err = p.tc.cachedFetch(requestKey(showTweetEndpoint, auth, query), tags, &tweet, func() ([]byte, error) {
+        return p.batcher.get(ctx, auth, req.GetId(), desTweetOptions(req.GetTwopts()).ser())
+      })
+    } else {
+      meta, err = p.tc.cachedRequest(ctx, showTweetEndpoint, auth, query, tags, &tweet)
+    }
+    if err != nil {
       return model.Tweet{}, nil, err
     }
-    return tweet, nil, nil
+    return tweet, meta, nil
   })
   if err != nil {
     return nil, err
@@ -45,12 +218,17 @@ func (p Proxy) GetTweet(ctx context.Context, req *pb.TweetRequest) (*pb.TweetRes
 
 func (p Proxy) GetTweets(ctx context.Context, req *pb.TweetsRequest) (*pb.TweetsResponse, error) {
   auth, query := reserTweetsRequest(req)
+  tags := make([]string, len(req.GetIds()))
+  for i, id := range req.GetIds() {
+    tags[i] = tweetCacheTag(id)
+  }
   resp, meta, err := generateTweetsResponse(func() (model.Timeline, metadata.MD, error) {
     var tweets model.Timeline
-    if err := p.tc.standardRequest(showTweetsEndpoint, auth, query, nil, &tweets); err != nil {
+    meta, err := p.tc.cachedRequest(ctx, showTweetsEndpoint, auth, query, tags, &tweets)
+    if err != nil {
       return nil, nil, err
     }
-    return tweets, nil, nil
+    return tweets, meta, nil
   })
   if err != nil {
     return nil, err
@@ -65,9 +243,10 @@ func (p Proxy) LikeTweet(ctx context.Context, req *pb.TweetRequest) (*pb.TweetRe
   auth, query := reserTweetRequest(req)
   resp, meta, err := generateTweetResponse(func() (model.Tweet, metadata.MD, error) {
     var tweet model.Tweet
-    if err := p.tc.standardRequest(likeEndpoint, auth, query, nil, &tweet); err != nil {
+    if err := p.tc.standardRequest(ctx, likeEndpoint, auth, query, nil, &tweet); err != nil {
       return model.Tweet{}, nil, err
     }
+    p.tc.invalidate(tweetCacheTag(req.GetId()))
     return tweet, nil, nil
   })
   if err != nil {
@@ -83,9 +262,10 @@ func (p Proxy) UnlikeTweet(ctx context.Context, req *pb.TweetRequest) (*pb.Tweet
   auth, query := reserTweetRequest(req)
   resp, meta, err := generateTweetResponse(func() (model.Tweet, metadata.MD, error) {
     var tweet model.Tweet
-    if err := p.tc.standardRequest(unlikeEndpoint, auth, query, nil, &tweet); err != nil {
+    if err := p.tc.standardRequest(ctx, unlikeEndpoint, auth, query, nil, &tweet); err != nil {
       return model.Tweet{}, nil, err
     }
+    p.tc.invalidate(tweetCacheTag(req.GetId()))
     return tweet, nil, nil
   })
   if err != nil {
@@ -101,9 +281,10 @@ func (p Proxy) RetweetTweet(ctx context.Context, req *pb.TweetRequest) (*pb.Twee
   auth, query := reserTweetRequest(req)
   resp, meta, err := generateTweetResponse(func() (model.Tweet, metadata.MD, error) {
     var tweet model.Tweet
-    if err := p.tc.standardRequest(retweetEndpoint, auth, query, nil, &tweet); err != nil {
+    if err := p.tc.standardRequest(ctx, retweetEndpoint, auth, query, nil, &tweet); err != nil {
       return model.Tweet{}, nil, err
     }
+    p.tc.invalidate(tweetCacheTag(req.GetId()))
     return tweet, nil, nil
   })
   if err != nil {
@@ -119,9 +300,10 @@ func (p Proxy) UnretweetTweet(ctx context.Context, req *pb.TweetRequest) (*pb.Tw
   auth, query := reserTweetRequest(req)
   resp, meta, err := generateTweetResponse(func() (model.Tweet, metadata.MD, error) {
     var tweet model.Tweet
-    if err := p.tc.standardRequest(unretweetEndpoint, auth, query, nil, &tweet); err != nil {
+    if err := p.tc.standardRequest(ctx, unretweetEndpoint, auth, query, nil, &tweet); err != nil {
       return model.Tweet{}, nil, err
     }
+    p.tc.invalidate(tweetCacheTag(req.GetId()))
     return tweet, nil, nil
   })
   if err != nil {
@@ -137,7 +319,7 @@ func (p Proxy) PublishTweet(ctx context.Context, req *pb.PublishTweetRequest) (*
   auth, query := reserPublishTweetRequest(req)
   resp, meta, err := generateTweetResponse(func() (model.Tweet, metadata.MD, error) {
     var tweet model.Tweet
-    if err := p.tc.standardRequest(publishTweetEndpoint, auth, query, nil, &tweet); err != nil {
+    if err := p.tc.standardRequest(ctx, publishTweetEndpoint, auth, query, nil, &tweet); err != nil {
       return model.Tweet{}, nil, err
     }
     return tweet, nil, nil
@@ -155,9 +337,10 @@ func (p Proxy) DeleteTweet(ctx context.Context, req *pb.TweetRequest) (*pb.Tweet
   auth, query := reserTweetRequest(req)
   resp, meta, err := generateTweetResponse(func() (model.Tweet, metadata.MD, error) {
     var tweet model.Tweet
-    if err := p.tc.standardRequest(destroyTweetEndpoint, auth, query, nil, &tweet); err != nil {
+    if err := p.tc.standardRequest(ctx, destroyTweetEndpoint, auth, query, nil, &tweet); err != nil {
       return model.Tweet{}, nil, err
     }
+    p.tc.invalidate(tweetCacheTag(req.GetId()))
     return tweet, nil, nil
   })
   if err != nil {
@@ -171,11 +354,12 @@ func (p Proxy) DeleteTweet(ctx context.Context, req *pb.TweetRequest) (*pb.Tweet
 
 func (p Proxy) GetHomeTimeline(ctx context.Context, req *pb.HomeTimelineRequest) (*pb.TweetsResponse, error) {
   auth := desAuth(req.GetAuth())
-  query := desTimelineOptions(req.GetTimelineOptions()).ser()
-  query.Set("exclude_replies", strconv.FormatBool(!req.GetIncludeReplies()))
+  tlOpts := desTimelineOptions(req.GetTimelineOptions())
+  params := oauth.NewParams()
+  params.Set("exclude_replies", strconv.FormatBool(!req.GetIncludeReplies()))
   resp, meta, err := generateTweetsResponse(func() (model.Timeline, metadata.MD, error) {
-    var tweets model.Timeline
-    if err := p.tc.standardRequest(homeTimelineEndpoint, auth, query, nil, &tweets); err != nil {
+    tweets, err := paginateTimeline(tlOpts, p.timelinePoller(ctx, homeTimelineEndpoint, auth, params))
+    if err != nil {
       return nil, nil, err
     }
     return tweets, nil, nil
@@ -191,10 +375,10 @@ func (p Proxy) GetHomeTimeline(ctx context.Context, req *pb.HomeTimelineRequest)
 
 func (p Proxy) GetMentionTimeline(ctx context.Context, req *pb.MentionTimelineRequest) (*pb.TweetsResponse, error) {
   auth := desAuth(req.GetAuth())
-  query := desTimelineOptions(req.GetTimelineOptions()).ser()
+  tlOpts := desTimelineOptions(req.GetTimelineOptions())
   resp, meta, err := generateTweetsResponse(func() (model.Timeline, metadata.MD, error) {
-    var tweets model.Timeline
-    if err := p.tc.standardRequest(mentionTimelineEndpoint, auth, query, nil, &tweets); err != nil {
+    tweets, err := paginateTimeline(tlOpts, p.timelinePoller(ctx, mentionTimelineEndpoint, auth, oauth.NewParams()))
+    if err != nil {
       return nil, nil, err
     }
     return tweets, nil, nil
@@ -210,17 +394,11 @@ func (p Proxy) GetMentionTimeline(ctx context.Context, req *pb.MentionTimelineRe
 
 func (p Proxy) GetUserTimeline(ctx context.Context, req *pb.UserTimelineRequest) (*pb.TweetsResponse, error) {
   auth := desAuth(req.GetAuth())
-  query := desTimelineOptions(req.GetTimelineOptions()).ser()
-  if id, ok := req.GetUser().(*pb.UserTimelineRequest_UserId); ok {
-    query.Set("user_id", strconv.FormatUint(id.UserId, 10))
-  } else if handle, ok := req.GetUser().(*pb.UserTimelineRequest_UserHandle); ok {
-    query.Set("screen_name", handle.UserHandle)
-  }
-  query.Set("exclude_replies", strconv.FormatBool(!req.GetIncludeReplies()))
-  query.Set("include_rts", strconv.FormatBool(req.GetIncludeRetweets()))
+  tlOpts := desTimelineOptions(req.GetTimelineOptions())
+  params := reserUserTimelineParams(req)
   resp, meta, err := generateTweetsResponse(func() (model.Timeline, metadata.MD, error) {
-    var tweets model.Timeline
-    if err := p.tc.standardRequest(userTimelineEndpoint, auth, query, nil, &tweets); err != nil {
+    tweets, err := paginateTimeline(tlOpts, p.timelinePoller(ctx, userTimelineEndpoint, auth, params))
+    if err != nil {
       return nil, nil, err
     }
     return tweets, nil, nil
@@ -234,33 +412,26 @@ func (p Proxy) GetUserTimeline(ctx context.Context, req *pb.UserTimelineRequest)
   return resp, nil
 }
 
-func (p Proxy) SearchTweets(ctx context.Context, req *pb.SearchRequest) (*pb.TweetsResponse, error) {
+func (p Proxy) SearchTweets(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
   auth := desAuth(req.GetAuth())
-
-  query := desTimelineOptions(req.GetTimelineOptions()).ser()
-  query.Set("q", req.GetQuery())
-  if geo := req.GetGeocode(); geo != nil {
-    query.Set("geocode", geo.Val)
-  }
-  if lang := req.GetLang(); lang != nil {
-    query.Set("lang", lang.Val)
-  }
-  if locale := req.GetLocale(); locale != nil {
-    query.Set("locale", locale.Val)
-  }
-  query.Set("result_type", reserSearchResultType(req.GetResultType()))
-  if untilUnix := req.GetUntilTimestamp(); untilUnix != nil {
-    until := time.Unix(untilUnix.Val, 0)
-    untilStr := until.Format("2006-01-02")
-    query.Set("until", untilStr)
-  }
-
+  tlOpts := desTimelineOptions(req.GetTimelineOptions())
+  params := reserSearchParams(req)
   resp, meta, err := generateSearchResultResponse(func() (model.SearchResult, metadata.MD, error) {
-    var tweets model.SearchResult
-    if err := p.tc.standardRequest(searchEndpoint, auth, query, nil, &tweets); err != nil {
+    // Only the metadata of the last page is kept, since it describes how to continue the search
+    var last model.SearchResult
+    tweets, err := paginateTimeline(tlOpts, func(tlOpts timelineOptions) (model.Timeline, error) {
+      result, err := p.searchPage(ctx, auth, params, tlOpts)
+      if err != nil {
+        return nil, err
+      }
+      last = result
+      return result.Statuses, nil
+    })
+    if err != nil {
       return model.SearchResult{}, nil, err
     }
-    return tweets, nil, nil
+    last.Statuses = tweets
+    return last, nil, nil
   })
   if err != nil {
     return nil, err
@@ -293,7 +464,7 @@ func (p Proxy) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest)
   }
   resp, meta, err := generateUserResponse(func() (model.User, metadata.MD, error) {
     var user model.User
-    if err := p.tc.standardRequest(updateProfileEndpoint, auth, query, nil, &user); err != nil {
+    if err := p.tc.standardRequest(ctx, updateProfileEndpoint, auth, query, nil, &user); err != nil {
       return model.User{}, nil, err
     }
     return user, nil, nil
@@ -307,9 +478,74 @@ func (p Proxy) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest)
   return resp, nil
 }
 
-func (p Proxy) GetRaw(ctx context.Context, req *pb.RawAPIRequest) (*pb.RawAPIResult, error) {
-  //TODO
-  panic("implement me")
+func (p Proxy) GetUser(ctx context.Context, req *pb.UserRequest) (*pb.UserResponse, error) {
+  auth, query := reserUserRequest(req)
+  resp, meta, err := generateUserResponse(func() (model.User, metadata.MD, error) {
+    var user model.User
+    if err := p.tc.standardRequest(ctx, showUserEndpoint, auth, query, nil, &user); err != nil {
+      return model.User{}, nil, err
+    }
+    return user, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) GetUsers(ctx context.Context, req *pb.UsersRequest) (*pb.UsersResponse, error) {
+  auth, query := reserUsersRequest(req)
+  resp, meta, err := generateUsersResponse(func() ([]model.User, metadata.MD, error) {
+    if len(req.GetUserIds())+len(req.GetUserHandles()) > maxUserLookup {
+      // Report the error the same way as the one Twitter would have responded with
+      return nil, nil, apiError{
+        code:       pb.Error_BAD_REQUEST,
+        status:     "400 Bad Request",
+        statusCode: http.StatusBadRequest,
+        message:    fmt.Sprintf("at most %d users can be looked up at once", maxUserLookup),
+      }
+    }
+    var users []model.User
+    if err := p.tc.standardRequest(ctx, showUsersEndpoint, auth, query, nil, &users); err != nil {
+      return nil, nil, err
+    }
+    return users, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
+}
+
+func (p Proxy) GetRaw(ctx context.Context, req *pb.RawAPIRequest) (*pb.RawAPIResponse, error) {
+  auth, ep, query, body := reserRawAPIRequest(req)
+  resp, meta, err := generateRawAPIResponse(func() (*pb.RawAPIResult, metadata.MD, error) {
+    var result *pb.RawAPIResult
+    if err := p.tc.rawOAuthRequest(ctx, ep, auth, query, body, func(resp *http.Response) error {
+      respBody, err := ioutil.ReadAll(resp.Body)
+      if err != nil {
+        return err
+      }
+      result = serRawAPIResult(resp.StatusCode, resp.Header, respBody)
+      return nil
+    }); err != nil {
+      return nil, nil, err
+    }
+    return result, nil, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  if err := sendHeader(ctx, meta); err != nil {
+    return nil, err
+  }
+  return resp, nil
 }
 
 func sendHeader(ctx context.Context, meta metadata.MD) error {
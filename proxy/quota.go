@@ -0,0 +1,134 @@
+package proxy
+
+import (
+  "context"
+  "google.golang.org/grpc/credentials"
+  "google.golang.org/grpc/metadata"
+  "google.golang.org/grpc/peer"
+  "sync"
+  "time"
+)
+
+const (
+  // Clients identify themselves by setting this metadata key to one of their API keys
+  apiKeyMetaKey = "goldcrest-api-key"
+  // Requests which do not identify as any configured client use the quotas of the client with this name
+  defaultClientName  = "default"
+  defaultQuotaWindow = time.Minute * 15
+)
+
+// A client of the proxy, which may be limited to using only part of each rate limit.
+type ClientIdentity struct {
+  Name string
+  // The client is identified by any of these values of the goldcrest-api-key request metadata
+  APIKeys []string
+  // The client is also identified by a verified TLS client certificate with any of these common names
+  CommonNames []string
+  // Keyed by the same names as Config.LimitReserves
+  Quotas map[string]Quota
+}
+
+// The number of requests a client may make to an endpoint in each window. If Window is zero, Twitter's usual
+// window of 15 minutes is used.
+type Quota struct {
+  Requests uint
+  Window   time.Duration
+}
+
+// Keeps track of how much of its quotas each client has used.
+type quotas struct {
+  byAPIKey     map[string]string
+  byCommonName map[string]string
+  limits       map[string]map[string]Quota
+  mx           sync.Mutex
+  windows      map[string]*quotaWindow
+}
+
+type quotaWindow struct {
+  used   uint
+  resets time.Time
+}
+
+func newQuotas(clients []ClientIdentity) *quotas {
+  q := &quotas{
+    byAPIKey:     make(map[string]string),
+    byCommonName: make(map[string]string),
+    limits:       make(map[string]map[string]Quota),
+    windows:      make(map[string]*quotaWindow),
+  }
+  for _, client := range clients {
+    for _, key := range client.APIKeys {
+      q.byAPIKey[key] = client.Name
+    }
+    for _, cn := range client.CommonNames {
+      q.byCommonName[cn] = client.Name
+    }
+    q.limits[client.Name] = client.Quotas
+  }
+  return q
+}
+
+// Returns the name of the client which made the request, preferring its API key over its TLS certificate.
+func (q *quotas) identify(ctx context.Context) string {
+  if meta, ok := metadata.FromIncomingContext(ctx); ok {
+    if vals := meta.Get(apiKeyMetaKey); len(vals) > 0 {
+      if name, ok := q.byAPIKey[vals[0]]; ok {
+        return name
+      }
+    }
+  }
+  if p, ok := peer.FromContext(ctx); ok {
+    if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+      for _, chain := range tlsInfo.State.VerifiedChains {
+        if len(chain) == 0 {
+          continue
+        }
+        if name, ok := q.byCommonName[chain[0].Subject.CommonName]; ok {
+          return name
+        }
+      }
+    }
+  }
+  return defaultClientName
+}
+
+// Counts the request against the quota of the client which made it, returning a quotaError if the quota has
+// been used up. The returned function gives the unit back, for requests which end up not being sent to
+// Twitter. Does nothing if q is nil.
+func (q *quotas) use(ctx context.Context, ep endpoint) (refund func(), err error) {
+  if q == nil {
+    return func() {}, nil
+  }
+  client := q.identify(ctx)
+  quota, ok := q.limits[client][ep.limitName()]
+  if !ok {
+    return func() {}, nil
+  }
+  window := quota.Window
+  if window <= 0 {
+    window = defaultQuotaWindow
+  }
+
+  q.mx.Lock()
+  defer q.mx.Unlock()
+
+  key := client + " " + ep.limitName()
+  now := time.Now()
+  qw, ok := q.windows[key]
+  if !ok || !now.Before(qw.resets) {
+    qw = &quotaWindow{resets: now.Add(window)}
+    q.windows[key] = qw
+  }
+  if qw.used >= quota.Requests {
+    return nil, newQuotaError(client, ep.limitName(), qw.resets)
+  }
+  qw.used++
+  return func() {
+    q.mx.Lock()
+    defer q.mx.Unlock()
+    // If the window has already reset, this has no effect on the new one
+    if qw.used > 0 {
+      qw.used--
+    }
+  }, nil
+}
@@ -0,0 +1,110 @@
+package proxy
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "github.com/pantonshire/goldcrest/proxy/oauth"
+  "google.golang.org/grpc/metadata"
+  "net/http"
+  "net/http/httptest"
+  "sync/atomic"
+  "testing"
+  "time"
+)
+
+func TestQuotas(t *testing.T) {
+  q := newQuotas([]ClientIdentity{
+    {
+      Name:    "crawler",
+      APIKeys: []string{"crawler-key"},
+      Quotas: map[string]Quota{
+        userTimelineEndpoint.limitName(): {Requests: 2, Window: time.Millisecond * 100},
+      },
+    },
+    {
+      Name: defaultClientName,
+      Quotas: map[string]Quota{
+        userTimelineEndpoint.limitName(): {Requests: 1},
+      },
+    },
+  })
+
+  crawler := metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyMetaKey, "crawler-key"))
+  for i := 0; i < 2; i++ {
+    if _, err := q.use(crawler, userTimelineEndpoint); err != nil {
+      t.Fatalf("crawler request %d: %v", i, err)
+    }
+  }
+  _, err := q.use(crawler, userTimelineEndpoint)
+  if _, ok := err.(quotaError); !ok {
+    t.Fatalf("got %v, expected quota error", err)
+  }
+
+  // Quotas only apply to the endpoints they are configured for
+  if _, err := q.use(crawler, showTweetEndpoint); err != nil {
+    t.Errorf("request to endpoint without quota: %v", err)
+  }
+
+  // Unidentified clients share the default client's quota, which is separate from the crawler's
+  anonymous := context.Background()
+  refund, err := q.use(anonymous, userTimelineEndpoint)
+  if err != nil {
+    t.Fatalf("anonymous request: %v", err)
+  }
+  _, err = q.use(anonymous, userTimelineEndpoint)
+  if _, ok := err.(quotaError); !ok {
+    t.Errorf("second anonymous request did not get a quota error")
+  }
+
+  // Requests which are not sent give their unit back
+  refund()
+  if _, err := q.use(anonymous, userTimelineEndpoint); err != nil {
+    t.Errorf("anonymous request after refund: %v", err)
+  }
+
+  time.Sleep(time.Millisecond * 100)
+  if _, err := q.use(crawler, userTimelineEndpoint); err != nil {
+    t.Errorf("crawler request after window reset: %v", err)
+  }
+}
+
+func TestQuotaRefund(t *testing.T) {
+  var requests int32
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    atomic.AddInt32(&requests, 1)
+    fmt.Fprint(w, "[]")
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  tc.quotas = newQuotas([]ClientIdentity{{
+    Name: defaultClientName,
+    Quotas: map[string]Quota{
+      userTimelineEndpoint.limitName(): {Requests: 1},
+    },
+  }})
+
+  // Requests refused by the rate limit never reach Twitter, so they do not use up the quota
+  current, next := uint(0), uint(1)
+  tc.ses.get("").getLimit(userTimelineEndpoint.limitKey()).restore(LimitSnapshot{
+    Current: &current,
+    Next:    &next,
+    Resets:  time.Now().Add(time.Millisecond * 50),
+  })
+  var tweets []json.RawMessage
+  for i := 0; i < 2; i++ {
+    err := tc.oauthRequest(context.Background(), userTimelineEndpoint, oauth.AuthPair{}, nil, nil, decodeJSON(&tweets))
+    if _, ok := err.(rateLimitError); !ok {
+      t.Fatalf("got %v, expected rate limit error", err)
+    }
+  }
+
+  time.Sleep(time.Millisecond * 50)
+  if err := tc.oauthRequest(context.Background(), userTimelineEndpoint, oauth.AuthPair{}, nil, nil, decodeJSON(&tweets)); err != nil {
+    t.Fatalf("request after rate limit reset: %v", err)
+  }
+  if requests != 1 {
+    t.Errorf("got %d upstream requests, expected 1", requests)
+  }
+}
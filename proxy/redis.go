@@ -0,0 +1,114 @@
+package proxy
+
+import (
+  "encoding/json"
+  "github.com/gomodule/redigo/redis"
+  "time"
+)
+
+const (
+  redisMaxIdle     = 16
+  redisIdleTimeout = time.Minute * 4
+  redisTimeout     = time.Second * 5
+  // Rate limit state which has not been updated for this long is discarded
+  redisStateTTL = time.Hour * 24
+)
+
+// A SharedLimitStore which keeps rate limit state in Redis. Updates use optimistic locking with WATCH and
+// MULTI, so any number of proxy instances may use the same Redis server.
+type RedisLimitStore struct {
+  pool   *redis.Pool
+  prefix string
+}
+
+func NewRedisLimitStore(address, password string, db int, prefix string) *RedisLimitStore {
+  return &RedisLimitStore{
+    pool: &redis.Pool{
+      MaxIdle:     redisMaxIdle,
+      IdleTimeout: redisIdleTimeout,
+      Dial: func() (redis.Conn, error) {
+        return redis.Dial("tcp", address,
+          redis.DialPassword(password),
+          redis.DialDatabase(db),
+          redis.DialConnectTimeout(redisTimeout),
+          redis.DialReadTimeout(redisTimeout),
+          redis.DialWriteTimeout(redisTimeout),
+        )
+      },
+    },
+    prefix: prefix,
+  }
+}
+
+func (store *RedisLimitStore) Get(token, key string) (SharedLimitState, error) {
+  conn := store.pool.Get()
+  defer conn.Close()
+  return redisGetLimitState(conn, store.redisKey(token, key))
+}
+
+func (store *RedisLimitStore) Update(token, key string, fn func(state *SharedLimitState) error) error {
+  conn := store.pool.Get()
+  defer conn.Close()
+
+  redisKey := store.redisKey(token, key)
+
+  for {
+    if _, err := conn.Do("WATCH", redisKey); err != nil {
+      return err
+    }
+
+    state, err := redisGetLimitState(conn, redisKey)
+    if err == nil {
+      err = fn(&state)
+    }
+    if err != nil {
+      conn.Do("UNWATCH")
+      return err
+    }
+
+    data, err := json.Marshal(state)
+    if err != nil {
+      conn.Do("UNWATCH")
+      return err
+    }
+
+    if err := conn.Send("MULTI"); err != nil {
+      return err
+    }
+    if err := conn.Send("SET", redisKey, data, "PX", redisStateTTL.Milliseconds()); err != nil {
+      return err
+    }
+    reply, err := conn.Do("EXEC")
+    if err != nil {
+      return err
+    }
+    if reply != nil {
+      return nil
+    }
+    // The transaction was aborted because another instance changed the state, so try again
+  }
+}
+
+// Closes all connections to the Redis server.
+func (store *RedisLimitStore) Close() error {
+  return store.pool.Close()
+}
+
+// Access tokens are hashed so that they are not stored in Redis.
+func (store *RedisLimitStore) redisKey(token, key string) string {
+  return store.prefix + tokenDigest(token) + ":" + key
+}
+
+func redisGetLimitState(conn redis.Conn, redisKey string) (SharedLimitState, error) {
+  var state SharedLimitState
+  data, err := redis.Bytes(conn.Do("GET", redisKey))
+  if err == redis.ErrNil {
+    return state, nil
+  } else if err != nil {
+    return state, err
+  }
+  if err := json.Unmarshal(data, &state); err != nil {
+    return state, err
+  }
+  return state, nil
+}
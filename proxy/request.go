@@ -2,9 +2,11 @@ package proxy
 
 import (
   pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
   "github.com/pantonshire/goldcrest/proxy/oauth"
   "strconv"
   "strings"
+  "time"
 )
 
 type tweetOptions struct {
@@ -17,10 +19,17 @@ type tweetOptions struct {
 }
 
 type timelineOptions struct {
-  count     uint
-  minID     *uint64
-  maxID     *uint64
-  tweetOpts tweetOptions
+  count      uint
+  minID      *uint64
+  maxID      *uint64
+  tweetOpts  tweetOptions
+  maxPages   uint
+  totalCount uint
+}
+
+type cursorOptions struct {
+  count  uint
+  cursor *int64
 }
 
 func desAuth(msg *pb.Authentication) oauth.AuthPair {
@@ -78,8 +87,10 @@ func desTimelineOptions(msg *pb.TimelineOptions) timelineOptions {
     return timelineOptions{}
   }
   opts := timelineOptions{
-    count:     uint(msg.Count),
-    tweetOpts: desTweetOptions(msg.Twopts),
+    count:      uint(msg.Count),
+    tweetOpts:  desTweetOptions(msg.Twopts),
+    maxPages:   uint(msg.MaxPages),
+    totalCount: uint(msg.TotalCount),
   }
   if msg.MinId != nil {
     opts.minID = new(uint64)
@@ -105,6 +116,33 @@ func (opts timelineOptions) ser() oauth.Params {
   return params
 }
 
+func desCursorOptions(msg *pb.CursorOptions) cursorOptions {
+  if msg == nil {
+    return cursorOptions{}
+  }
+  opts := cursorOptions{
+    count: uint(msg.Count),
+  }
+  if msg.Cursor != nil {
+    opts.cursor = new(int64)
+    *opts.cursor = msg.Cursor.Val
+  }
+  return opts
+}
+
+func (opts cursorOptions) ser() oauth.Params {
+  params := oauth.NewParams()
+  if opts.count > 0 {
+    params.Set("count", strconv.FormatUint(uint64(opts.count), 10))
+  }
+  if opts.cursor != nil {
+    params.Set("cursor", strconv.FormatInt(*opts.cursor, 10))
+  } else {
+    params.Set("cursor", "-1")
+  }
+  return params
+}
+
 func reserTweetRequest(msg *pb.TweetRequest) (oauth.AuthPair, oauth.Params) {
   if msg == nil {
     return oauth.AuthPair{}, nil
@@ -123,11 +161,7 @@ func reserTweetsRequest(msg *pb.TweetsRequest) (oauth.AuthPair, oauth.Params) {
   auth := desAuth(msg.Auth)
   params := oauth.NewParams()
   if len(msg.Ids) > 0 {
-    ids := make([]string, len(msg.Ids))
-    for i, id := range msg.Ids {
-      ids[i] = strconv.FormatUint(id, 10)
-    }
-    params.Set("id", strings.Join(ids, ","))
+    params.Set("id", serIDList(msg.Ids))
   }
   params.Extend(desTweetOptions(msg.Twopts).ser())
   return auth, params
@@ -151,23 +185,47 @@ func reserPublishTweetRequest(msg *pb.PublishTweetRequest) (oauth.AuthPair, oaut
     params.Set("attachment_url", msg.AttachmentUrl.Val)
   }
   if len(msg.ExcludeReplyUserIds) > 0 {
-    exclude := make([]string, len(msg.ExcludeReplyUserIds))
-    for i, id := range msg.ExcludeReplyUserIds {
-      exclude[i] = strconv.FormatUint(id, 10)
-    }
-    params.Set("exclude_reply_user_ids", strings.Join(exclude, ","))
+    params.Set("exclude_reply_user_ids", serIDList(msg.ExcludeReplyUserIds))
   }
   if len(msg.MediaIds) > 0 {
-    ids := make([]string, len(msg.MediaIds))
-    for i, id := range msg.MediaIds {
-      ids[i] = strconv.FormatUint(id, 10)
-    }
-    params.Set("media_ids", strings.Join(ids, ","))
+    params.Set("media_ids", serIDList(msg.MediaIds))
   }
   params.Extend(desTweetOptions(msg.Twopts).ser())
   return auth, params
 }
 
+func reserUserTimelineParams(msg *pb.UserTimelineRequest) oauth.Params {
+  params := oauth.NewParams()
+  if id, ok := msg.GetUser().(*pb.UserTimelineRequest_UserId); ok {
+    params.Set("user_id", strconv.FormatUint(id.UserId, 10))
+  } else if handle, ok := msg.GetUser().(*pb.UserTimelineRequest_UserHandle); ok {
+    params.Set("screen_name", handle.UserHandle)
+  }
+  params.Set("exclude_replies", strconv.FormatBool(!msg.GetIncludeReplies()))
+  params.Set("include_rts", strconv.FormatBool(msg.GetIncludeRetweets()))
+  return params
+}
+
+func reserSearchParams(msg *pb.SearchRequest) oauth.Params {
+  params := oauth.NewParams()
+  params.Set("q", msg.GetQuery())
+  if geo := msg.GetGeocode(); geo != nil {
+    params.Set("geocode", geo.Val)
+  }
+  if lang := msg.GetLang(); lang != nil {
+    params.Set("lang", lang.Val)
+  }
+  if locale := msg.GetLocale(); locale != nil {
+    params.Set("locale", locale.Val)
+  }
+  params.Set("result_type", reserSearchResultType(msg.GetResultType()))
+  if untilUnix := msg.GetUntilTimestamp(); untilUnix != nil {
+    until := time.Unix(untilUnix.Val, 0)
+    params.Set("until", until.Format("2006-01-02"))
+  }
+  return params
+}
+
 func reserSearchResultType(resType pb.SearchRequest_ResultType) string {
   switch resType {
   case pb.SearchRequest_RECENT:
@@ -178,3 +236,182 @@ func reserSearchResultType(resType pb.SearchRequest_ResultType) string {
     return "mixed"
   }
 }
+
+func reserRawAPIRequest(msg *pb.RawAPIRequest) (oauth.AuthPair, endpoint, oauth.Params, oauth.Params) {
+  if msg == nil {
+    return oauth.AuthPair{}, endpoint{method: methodGet, raw: true}, nil, nil
+  }
+  auth := desAuth(msg.Auth)
+  ep := endpoint{
+    path:   strings.TrimPrefix(msg.Path, "/"),
+    method: requestMethod(strings.ToUpper(strAlt(msg.Method, methodGet.String()))),
+    raw:    true,
+  }
+  query, body := oauth.NewParams(), oauth.NewParams()
+  query.Extend(msg.QueryParams)
+  body.Extend(msg.BodyParams)
+  return auth, ep, query, body
+}
+
+func serIDList(ids []uint64) string {
+  strs := make([]string, len(ids))
+  for i, id := range ids {
+    strs[i] = strconv.FormatUint(id, 10)
+  }
+  return strings.Join(strs, ",")
+}
+
+func reserUploadMediaRequest(msg *pb.UploadMediaRequest) (oauth.AuthPair, oauth.Params, []oauth.File) {
+  if msg == nil {
+    return oauth.AuthPair{}, nil, nil
+  }
+  auth := desAuth(msg.Auth)
+  params := oauth.NewParams()
+  if msg.MediaCategory != "" {
+    params.Set("media_category", msg.MediaCategory)
+  }
+  if len(msg.AdditionalOwners) > 0 {
+    params.Set("additional_owners", serIDList(msg.AdditionalOwners))
+  }
+  files := []oauth.File{{Field: "media", Data: msg.Media}}
+  return auth, params, files
+}
+
+func reserMediaInit(msg *pb.MediaChunk_Init) (oauth.AuthPair, oauth.Params) {
+  if msg == nil {
+    return oauth.AuthPair{}, nil
+  }
+  auth := desAuth(msg.Auth)
+  params := oauth.NewParams()
+  params.Set("command", "INIT")
+  params.Set("total_bytes", strconv.FormatUint(msg.TotalBytes, 10))
+  params.Set("media_type", msg.MediaType)
+  if msg.MediaCategory != "" {
+    params.Set("media_category", msg.MediaCategory)
+  }
+  if len(msg.AdditionalOwners) > 0 {
+    params.Set("additional_owners", serIDList(msg.AdditionalOwners))
+  }
+  return auth, params
+}
+
+func reserUserRequest(msg *pb.UserRequest) (oauth.AuthPair, oauth.Params) {
+  if msg == nil {
+    return oauth.AuthPair{}, nil
+  }
+  auth := desAuth(msg.Auth)
+  params := oauth.NewParams()
+  if id, ok := msg.User.(*pb.UserRequest_UserId); ok {
+    params.Set("user_id", strconv.FormatUint(id.UserId, 10))
+  } else if handle, ok := msg.User.(*pb.UserRequest_UserHandle); ok {
+    params.Set("screen_name", handle.UserHandle)
+  }
+  params.Set("include_entities", strconv.FormatBool(msg.IncludeEntities))
+  return auth, params
+}
+
+func reserUsersRequest(msg *pb.UsersRequest) (oauth.AuthPair, oauth.Params) {
+  if msg == nil {
+    return oauth.AuthPair{}, nil
+  }
+  auth := desAuth(msg.Auth)
+  params := oauth.NewParams()
+  if len(msg.UserIds) > 0 {
+    params.Set("user_id", serIDList(msg.UserIds))
+  }
+  if len(msg.UserHandles) > 0 {
+    params.Set("screen_name", strings.Join(msg.UserHandles, ","))
+  }
+  params.Set("include_entities", strconv.FormatBool(msg.IncludeEntities))
+  return auth, params
+}
+
+// The statuses and entities options are only sent if full user objects are requested, since the ids
+// endpoints do not accept them.
+func reserUserGraphParams(msg *pb.UserGraphRequest, fullUsers bool) oauth.Params {
+  params := oauth.NewParams()
+  if id, ok := msg.GetUser().(*pb.UserGraphRequest_UserId); ok {
+    params.Set("user_id", strconv.FormatUint(id.UserId, 10))
+  } else if handle, ok := msg.GetUser().(*pb.UserGraphRequest_UserHandle); ok {
+    params.Set("screen_name", handle.UserHandle)
+  }
+  if fullUsers {
+    params.Set("skip_status", strconv.FormatBool(!msg.GetIncludeStatuses()))
+    params.Set("include_user_entities", strconv.FormatBool(msg.GetIncludeEntities()))
+  }
+  return params
+}
+
+// friendships/show takes the user as a target, since the source defaults to the authenticated user
+func reserRelationshipRequest(msg *pb.UserRequest) (oauth.AuthPair, oauth.Params) {
+  if msg == nil {
+    return oauth.AuthPair{}, nil
+  }
+  auth := desAuth(msg.Auth)
+  params := oauth.NewParams()
+  if id, ok := msg.User.(*pb.UserRequest_UserId); ok {
+    params.Set("target_id", strconv.FormatUint(id.UserId, 10))
+  } else if handle, ok := msg.User.(*pb.UserRequest_UserHandle); ok {
+    params.Set("target_screen_name", handle.UserHandle)
+  }
+  return auth, params
+}
+
+func reserSendDirectMessageRequest(msg *pb.SendDirectMessageRequest) (oauth.AuthPair, model.NewDirectMessage) {
+  var mediaID *uint64
+  if msg.GetMediaId() != nil {
+    mediaID = new(uint64)
+    *mediaID = msg.MediaId.Val
+  }
+  return desAuth(msg.GetAuth()), model.NewDirectMessageEvent(msg.GetRecipientId(), msg.GetText(), mediaID)
+}
+
+func reserListDirectMessagesRequest(msg *pb.ListDirectMessagesRequest) (oauth.AuthPair, oauth.Params) {
+  params := oauth.NewParams()
+  if msg.GetCount() > 0 {
+    params.Set("count", strconv.FormatUint(uint64(msg.Count), 10))
+  }
+  if msg.GetCursor() != "" {
+    params.Set("cursor", msg.Cursor)
+  }
+  return desAuth(msg.GetAuth()), params
+}
+
+func reserListID(id uint64) oauth.Params {
+  params := oauth.NewParams()
+  params.Set("list_id", strconv.FormatUint(id, 10))
+  return params
+}
+
+func reserListTimelineParams(msg *pb.ListTimelineRequest) oauth.Params {
+  params := reserListID(msg.GetListId())
+  params.Set("include_rts", strconv.FormatBool(msg.GetIncludeRetweets()))
+  return params
+}
+
+func reserListMembersParams(msg *pb.ListMembersRequest) oauth.Params {
+  params := reserListID(msg.GetListId())
+  params.Set("skip_status", strconv.FormatBool(!msg.GetIncludeStatuses()))
+  params.Set("include_entities", strconv.FormatBool(msg.GetIncludeEntities()))
+  return params
+}
+
+func reserListMemberParams(msg *pb.ListMemberRequest) oauth.Params {
+  params := reserListID(msg.GetListId())
+  if id, ok := msg.GetUser().(*pb.ListMemberRequest_UserId); ok {
+    params.Set("user_id", strconv.FormatUint(id.UserId, 10))
+  } else if handle, ok := msg.GetUser().(*pb.ListMemberRequest_UserHandle); ok {
+    params.Set("screen_name", handle.UserHandle)
+  }
+  return params
+}
+
+func reserOwnedListsParams(msg *pb.OwnedListsRequest) oauth.Params {
+  params := oauth.NewParams()
+  if id, ok := msg.GetUser().(*pb.OwnedListsRequest_UserId); ok {
+    params.Set("user_id", strconv.FormatUint(id.UserId, 10))
+  } else if handle, ok := msg.GetUser().(*pb.OwnedListsRequest_UserHandle); ok {
+    params.Set("screen_name", handle.UserHandle)
+  }
+  return params
+}
@@ -46,15 +46,135 @@ func generateUserResponse(generator func() (model.User, metadata.MD, error)) (*p
   return &pb.UserResponse{Response: &pb.UserResponse_User{User: serUser(user)}}, meta, nil
 }
 
-// Search metadata is dropped for now
-func generateSearchResultResponse(generator func() (model.SearchResult, metadata.MD, error)) (*pb.TweetsResponse, metadata.MD, error) {
+func generateUsersResponse(generator func() ([]model.User, metadata.MD, error)) (*pb.UsersResponse, metadata.MD, error) {
+  users, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.UsersResponse{Response: &pb.UsersResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.UsersResponse{Response: &pb.UsersResponse_Users{Users: serUsers(users)}}, meta, nil
+}
+
+func generateUserIDsResponse(generator func() (model.UserIDPage, metadata.MD, error)) (*pb.UserIdsResponse, metadata.MD, error) {
+  page, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.UserIdsResponse{Response: &pb.UserIdsResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.UserIdsResponse{Response: &pb.UserIdsResponse_Page{Page: serUserIDPage(page)}}, meta, nil
+}
+
+func generateUserPageResponse(generator func() (model.UserPage, metadata.MD, error)) (*pb.UserPageResponse, metadata.MD, error) {
+  page, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.UserPageResponse{Response: &pb.UserPageResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.UserPageResponse{Response: &pb.UserPageResponse_Page{Page: serUserPage(page)}}, meta, nil
+}
+
+func generateRelationshipResponse(generator func() (model.Relationship, metadata.MD, error)) (*pb.RelationshipResponse, metadata.MD, error) {
+  rel, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.RelationshipResponse{Response: &pb.RelationshipResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.RelationshipResponse{Response: &pb.RelationshipResponse_Relationship{Relationship: serRelationship(rel)}}, meta, nil
+}
+
+func generateDirectMessageResponse(generator func() (model.DirectMessageEvent, metadata.MD, error)) (*pb.DirectMessageResponse, metadata.MD, error) {
+  event, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.DirectMessageResponse{Response: &pb.DirectMessageResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.DirectMessageResponse{Response: &pb.DirectMessageResponse_Message{Message: serDirectMessage(event)}}, meta, nil
+}
+
+func generateDirectMessagesResponse(generator func() (model.DirectMessageEventList, metadata.MD, error)) (*pb.DirectMessagesResponse, metadata.MD, error) {
+  list, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.DirectMessagesResponse{Response: &pb.DirectMessagesResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.DirectMessagesResponse{Response: &pb.DirectMessagesResponse_Page{Page: serDirectMessagePage(list)}}, meta, nil
+}
+
+func generateListResponse(generator func() (model.List, metadata.MD, error)) (*pb.ListResponse, metadata.MD, error) {
+  list, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.ListResponse{Response: &pb.ListResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.ListResponse{Response: &pb.ListResponse_List{List: serList(list)}}, meta, nil
+}
+
+func generateListPageResponse(generator func() (model.ListPage, metadata.MD, error)) (*pb.ListPageResponse, metadata.MD, error) {
+  page, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.ListPageResponse{Response: &pb.ListPageResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.ListPageResponse{Response: &pb.ListPageResponse_Page{Page: serListPage(page)}}, meta, nil
+}
+
+func generateMediaResponse(generator func() (model.UploadedMedia, metadata.MD, error)) (*pb.MediaResponse, metadata.MD, error) {
+  media, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.MediaResponse{Response: &pb.MediaResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.MediaResponse{Response: &pb.MediaResponse_Media{Media: serUploadedMedia(media)}}, meta, nil
+}
+
+func generateRawAPIResponse(generator func() (*pb.RawAPIResult, metadata.MD, error)) (*pb.RawAPIResponse, metadata.MD, error) {
   result, meta, err := generator()
   if err != nil {
     if errMsg, errMeta := serError(err); errMsg != nil {
-      return &pb.TweetsResponse{Response: &pb.TweetsResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+      return &pb.RawAPIResponse{Response: &pb.RawAPIResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
     }
     return nil, nil, err
   }
-  return &pb.TweetsResponse{Response: &pb.TweetsResponse_Tweets{Tweets: serTimeline(result.Statuses)}}, meta, nil
+  return &pb.RawAPIResponse{Response: &pb.RawAPIResponse_Result{Result: result}}, meta, nil
 }
 
+func generateSearchResultResponse(generator func() (model.SearchResult, metadata.MD, error)) (*pb.SearchResponse, metadata.MD, error) {
+  result, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.SearchResponse{Response: &pb.SearchResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.SearchResponse{Response: &pb.SearchResponse_Result{Result: serSearchResult(result)}}, meta, nil
+}
+
+
+func generateRateLimitsResponse(generator func() (map[string]LimitSnapshot, metadata.MD, error)) (*pb.RateLimitsResponse, metadata.MD, error) {
+  limits, meta, err := generator()
+  if err != nil {
+    if errMsg, errMeta := serError(err); errMsg != nil {
+      return &pb.RateLimitsResponse{Response: &pb.RateLimitsResponse_Error{Error: errMsg}}, metadata.Join(meta, errMeta), nil
+    }
+    return nil, nil, err
+  }
+  return &pb.RateLimitsResponse{Response: &pb.RateLimitsResponse_Limits{Limits: serRateLimits(limits)}}, meta, nil
+}
@@ -0,0 +1,79 @@
+package proxy
+
+import (
+  "context"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/metadata"
+  "math/rand"
+  "net/http"
+  "strconv"
+  "time"
+)
+
+// The number of times a request to Twitter was retried is reported to the client under this response metadata
+// key, once for each request which needed retrying.
+const retriesMetaKey = "goldcrest-upstream-retries"
+
+// Decides whether to retry requests to Twitter which fail for reasons that are likely to be temporary.
+type retryPolicy struct {
+  // The most times to retry a single request. Zero disables retrying.
+  retries uint
+  // The delay before the first retry, which doubles with each retry after it up to maxBackoff
+  backoff    time.Duration
+  maxBackoff time.Duration
+}
+
+// Returns how long to wait before retrying, or false if the request should not be retried. GET requests are
+// retried after connection errors and 5xx responses, since they are idempotent; other requests are only retried
+// if the connection failed before any of the request was sent.
+func (rp retryPolicy) next(ctx context.Context, retries uint, ep endpoint, resp *http.Response, err error) (time.Duration, bool) {
+  if retries >= rp.retries {
+    return 0, false
+  }
+  idempotent := ep.method == methodGet
+  if connErr, ok := err.(connectionError); ok {
+    if !idempotent && connErr.sent {
+      return 0, false
+    }
+  } else if err != nil || resp == nil || !idempotent || !transientStatus(resp.StatusCode) {
+    return 0, false
+  }
+  delay := rp.delay(retries)
+  if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+    return 0, false
+  }
+  return delay, true
+}
+
+// Picks a random delay of up to backoff * 2^retries, so that requests which failed together do not all retry
+// at the same moment.
+func (rp retryPolicy) delay(retries uint) time.Duration {
+  limit := rp.backoff
+  for i := uint(0); i < retries && (rp.maxBackoff <= 0 || limit < rp.maxBackoff); i++ {
+    limit *= 2
+  }
+  if rp.maxBackoff > 0 && limit > rp.maxBackoff {
+    limit = rp.maxBackoff
+  }
+  if limit <= 0 {
+    return 0
+  }
+  return time.Duration(rand.Int63n(int64(limit)) + 1)
+}
+
+func transientStatus(status int) bool {
+  switch status {
+  case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+    return true
+  default:
+    return false
+  }
+}
+
+// Tells the client how many times a request was retried. This does nothing if ctx does not belong to an RPC or
+// the response headers have already been sent (e.g. for later pages of a stream), in which case the retries
+// are only logged.
+func (tc twitterClient) reportRetries(ctx context.Context, ep endpoint, retries uint) {
+  tc.logger(ctx).WithField("endpoint", ep.path).WithField("retries", retries).Info("Request to Twitter needed retrying")
+  grpc.SetHeader(ctx, metadata.Pairs(retriesMetaKey, strconv.FormatUint(uint64(retries), 10)))
+}
@@ -0,0 +1,60 @@
+package proxy
+
+import (
+  "context"
+  "fmt"
+  "google.golang.org/grpc"
+  "net/http"
+  "net/http/httptest"
+  "sync/atomic"
+  "testing"
+  "time"
+)
+
+func TestUpstreamRetry(t *testing.T) {
+  var requests int32
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    // The first two requests fail as though Twitter were over capacity
+    n := atomic.AddInt32(&requests, 1)
+    w.Header().Set(headerRateLimitRemaining, fmt.Sprint(900-n))
+    if n <= 2 {
+      w.WriteHeader(http.StatusServiceUnavailable)
+      fmt.Fprint(w, `{"errors":[{"code":130,"message":"Over capacity"}]}`)
+      return
+    }
+    fmt.Fprint(w, `{"id": 123}`)
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  tc.retry = retryPolicy{retries: 2, backoff: time.Millisecond}
+  auth, query := reserTweetRequest(nil)
+
+  stream := &headerStream{}
+  ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+  var tweet struct {
+    ID uint64 `json:"id"`
+  }
+  if err := tc.standardRequest(ctx, showTweetEndpoint, auth, query, nil, &tweet); err != nil {
+    t.Fatalf("request: %v", err)
+  }
+  if requests != 3 {
+    t.Errorf("got %d upstream requests, expected 3", requests)
+  }
+  if retries := stream.header.Get(retriesMetaKey); len(retries) != 1 || retries[0] != "2" {
+    t.Errorf("got retries header %v, expected 2", retries)
+  }
+  snapshot, _ := tc.ses.get(auth.Public.Token).getLimit(showTweetEndpoint.limitKey()).snapshot()
+  if snapshot.Current == nil || *snapshot.Current != 897 {
+    t.Errorf("got remaining %v, expected every attempt to use the rate limit", snapshot.Current)
+  }
+
+  // Requests which are not idempotent are not retried once Twitter has received them
+  atomic.StoreInt32(&requests, 0)
+  if err := tc.standardRequest(context.Background(), likeEndpoint, auth, query, nil, &tweet); err == nil {
+    t.Errorf("expected an error from the failed like")
+  }
+  if requests != 1 {
+    t.Errorf("got %d upstream requests for a like, expected 1", requests)
+  }
+}
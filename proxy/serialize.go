@@ -3,6 +3,9 @@ package proxy
 import (
   pb "github.com/pantonshire/goldcrest/protocol"
   "github.com/pantonshire/goldcrest/proxy/model"
+  "net/http"
+  "sort"
+  "strings"
 )
 
 func serTimeline(mods model.Timeline) *pb.Tweets {
@@ -62,6 +65,14 @@ func serTweet(mod model.Tweet) *pb.Tweet {
   return &msg
 }
 
+func serUsers(mods []model.User) *pb.Users {
+  msgs := make([]*pb.User, len(mods))
+  for i, mod := range mods {
+    msgs[i] = serUser(mod)
+  }
+  return &pb.Users{Users: msgs}
+}
+
 func serUser(mod model.User) *pb.User {
   return &pb.User{
     Id:                  mod.ID,
@@ -212,3 +223,197 @@ func serPollOptions(mods []model.PollOption) []*pb.Poll_Option {
   }
   return msgs
 }
+
+func serRawAPIResult(status int, header http.Header, body []byte) *pb.RawAPIResult {
+  headers := make(map[string]string, len(header))
+  for key, vals := range header {
+    headers[key] = strings.Join(vals, ", ")
+  }
+  return &pb.RawAPIResult{
+    Headers: headers,
+    Status:  uint32(status),
+    Body:    body,
+  }
+}
+
+func serUploadedMedia(mod model.UploadedMedia) *pb.UploadedMedia {
+  msg := pb.UploadedMedia{
+    Id:               mod.MediaID,
+    Size:             mod.Size,
+    ExpiresAfterSecs: mod.ExpiresAfterSecs,
+  }
+  if info := mod.ProcessingInfo; info != nil {
+    msg.Processing = &pb.UploadedMedia_Processing{
+      State:           serProcessingState(info.State),
+      CheckAfterSecs:  info.CheckAfterSecs,
+      ProgressPercent: info.ProgressPercent,
+    }
+    if info.Error != nil {
+      msg.Processing.Error = strAlt(info.Error.Message, info.Error.Name)
+    }
+  }
+  return &msg
+}
+
+func serProcessingState(state string) pb.UploadedMedia_Processing_State {
+  switch state {
+  case "in_progress":
+    return pb.UploadedMedia_Processing_IN_PROGRESS
+  case "succeeded":
+    return pb.UploadedMedia_Processing_SUCCEEDED
+  case "failed":
+    return pb.UploadedMedia_Processing_FAILED
+  default:
+    return pb.UploadedMedia_Processing_PENDING
+  }
+}
+
+func serUserIDPage(mod model.UserIDPage) *pb.UserIdPage {
+  return &pb.UserIdPage{
+    Ids:            mod.IDs,
+    NextCursor:     mod.NextCursor,
+    PreviousCursor: mod.PreviousCursor,
+  }
+}
+
+func serUserPage(mod model.UserPage) *pb.UserPage {
+  return &pb.UserPage{
+    Users:          serUsers(mod.Users).Users,
+    NextCursor:     mod.NextCursor,
+    PreviousCursor: mod.PreviousCursor,
+  }
+}
+
+func serRelationship(mod model.Relationship) *pb.Relationship {
+  msg := pb.Relationship{
+    UserId:               mod.ID,
+    UserHandle:           mod.ScreenName,
+    Following:            mod.Following,
+    FollowingRequested:   mod.FollowingRequested,
+    NotificationsEnabled: mod.NotificationsEnabled,
+    FollowedBy:           mod.FollowedBy,
+    CanDm:                mod.CanDM,
+  }
+  if mod.User != nil {
+    msg.User = serUser(*mod.User)
+  }
+  if mod.Blocking != nil {
+    msg.Blocking = &pb.OptBool{Val: *mod.Blocking}
+  }
+  if mod.Muting != nil {
+    msg.Muting = &pb.OptBool{Val: *mod.Muting}
+  }
+  return &msg
+}
+
+func serDirectMessagePage(mod model.DirectMessageEventList) *pb.DirectMessagePage {
+  // Other kinds of event are not direct messages, so they are left out
+  messages := make([]*pb.DirectMessage, 0, len(mod.Events))
+  for _, event := range mod.Events {
+    if event.IsMessage() {
+      messages = append(messages, serDirectMessage(event))
+    }
+  }
+  return &pb.DirectMessagePage{
+    Messages:   messages,
+    NextCursor: mod.NextCursor,
+  }
+}
+
+func serDirectMessage(mod model.DirectMessageEvent) *pb.DirectMessage {
+  data := mod.MessageCreate.MessageData
+  msg := pb.DirectMessage{
+    Id:          mod.ID,
+    CreatedAt:   mod.CreatedAt().Unix(),
+    SenderId:    mod.MessageCreate.SenderID,
+    RecipientId: mod.MessageCreate.Target.RecipientID,
+    Text:        data.Text,
+    Hashtags:    serSymbols(data.Entities.Hashtags),
+    Urls:        serURLs(data.Entities.URLs),
+    Mentions:    serMentions(data.Entities.Mentions),
+    Symbols:     serSymbols(data.Entities.Symbols),
+  }
+  if data.Attachment != nil {
+    msg.Media = serMediaItems([]model.Media{data.Attachment.Media})
+  }
+  return &msg
+}
+
+func serListPage(mod model.ListPage) *pb.ListPage {
+  lists := make([]*pb.List, len(mod.Lists))
+  for i, list := range mod.Lists {
+    lists[i] = serList(list)
+  }
+  return &pb.ListPage{
+    Lists:          lists,
+    NextCursor:     mod.NextCursor,
+    PreviousCursor: mod.PreviousCursor,
+  }
+}
+
+func serList(mod model.List) *pb.List {
+  return &pb.List{
+    Id:              mod.ID,
+    Name:            mod.Name,
+    Slug:            mod.Slug,
+    FullName:        mod.FullName,
+    Description:     mod.Description,
+    CreatedAt:       mod.CreatedAt.Unix(),
+    Private:         mod.Private(),
+    MemberCount:     mod.MemberCount,
+    SubscriberCount: mod.SubscriberCount,
+    Following:       mod.Following,
+    Owner:           serUser(mod.User),
+  }
+}
+
+func serSearchResult(mod model.SearchResult) *pb.SearchResult {
+  return &pb.SearchResult{
+    Tweets:   serTimeline(mod.Statuses).Tweets,
+    Metadata: serSearchMetadata(mod.Meta),
+  }
+}
+
+func serSearchMetadata(mod model.SearchMetadata) *pb.SearchMetadata {
+  msg := pb.SearchMetadata{
+    NextResults: mod.NextResults,
+    RefreshUrl:  mod.RefreshURL,
+    MaxId:       mod.MaxID,
+    SinceId:     mod.SinceID,
+    Count:       uint32(mod.Count),
+    CompletedIn: mod.CompletedIn,
+    Query:       mod.Query,
+  }
+  if nextMaxID, ok := mod.NextMaxID(); ok {
+    msg.NextMaxId = &pb.OptFixed64{Val: nextMaxID}
+  }
+  return &msg
+}
+
+// The limits are sorted by key so that the order is stable.
+func serRateLimits(limits map[string]LimitSnapshot) *pb.RateLimits {
+  keys := make([]string, 0, len(limits))
+  for key := range limits {
+    keys = append(keys, key)
+  }
+  sort.Strings(keys)
+  msgs := make([]*pb.RateLimit, len(keys))
+  for i, key := range keys {
+    msgs[i] = serRateLimit(key, limits[key])
+  }
+  return &pb.RateLimits{Limits: msgs}
+}
+
+func serRateLimit(key string, snapshot LimitSnapshot) *pb.RateLimit {
+  msg := pb.RateLimit{Key: key}
+  if snapshot.Current != nil {
+    msg.Current = &pb.OptUint64{Val: uint64(*snapshot.Current)}
+  }
+  if snapshot.Next != nil {
+    msg.Next = &pb.OptUint64{Val: uint64(*snapshot.Next)}
+  }
+  if !snapshot.Resets.IsZero() {
+    msg.Resets = snapshot.Resets.Unix()
+  }
+  return &msg
+}
@@ -1,26 +1,93 @@
 package proxy
 
 import (
+  "context"
+  "github.com/sirupsen/logrus"
+  "google.golang.org/grpc/metadata"
   "sync"
   "time"
 )
 
 const (
   stuckResetTime = time.Minute * 20
+  // How long to wait before trying again after hitting a rate limit whose reset time is unknown
+  rateLimitFallbackWait = time.Minute
+  // Clients set this metadata key to "true" to have their requests wait for the rate limit to reset rather
+  // than receiving a rate limit error
+  waitMetaKey = "goldcrest-wait"
+  // The most rate limits each session keeps for paths which have only been requested through GetRaw
+  maxRawLimits = 64
 )
 
+// A limiter tracks a single rate limit for a single access token.
+type limiter interface {
+  // Called before making a request. Returns an error if the request must not be made because the rate limit
+  // has been exhausted, or because making it would leave fewer than reserve units for other requests. log is
+  // the logger of the request. Any waiting which use does ends early if ctx is done.
+  use(ctx context.Context, log logrus.FieldLogger, reserve uint) error
+  // Called once a request permitted by use has finished, with any rate limit information from the response.
+  finish(log logrus.FieldLogger, current, next *uint, resets *time.Time, forceSync bool)
+  pacing(now time.Time) time.Duration
+  snapshot() (LimitSnapshot, bool)
+  restore(snapshot LimitSnapshot)
+}
+
+type limitFactory func(token, key string) limiter
+
+func localLimits(assumeNext bool) limitFactory {
+  return func(_, _ string) limiter {
+    return newRateLimit(assumeNext)
+  }
+}
+
 type sessions struct {
-  mx              sync.Mutex
-  cache           map[string]*session
-  assumeNextLimit bool
+  mx       sync.Mutex
+  cache    map[string]*session
+  newLimit limitFactory
+  // Saved limits for the access tokens which have not been seen since they were loaded, keyed by tokenDigest
+  saved LimitSnapshots
 }
 
 type session struct {
-  mx              sync.Mutex
-  limits          map[string]*rateLimit
-  assumeNextLimit bool
+  mx       sync.Mutex
+  token    string
+  limits   map[string]*queuedLimit
+  newLimit limitFactory
+  // The keys of the limits which have only been used by requests to paths chosen by clients
+  raw       map[string]bool
+  warmUpMx  sync.Mutex
+  warmedUp  bool
+  warmingUp bool
+  // When to try warming up the session again after failing to
+  warmUpRetry time.Time
+}
+
+// Wraps a limiter with queues for requests which would rather wait for the rate limit to reset than fail.
+type queuedLimit struct {
+  limiter
+  mx sync.Mutex
+  // Requests of each priority wait in their own line, so that background requests which are held back by the
+  // reserve do not hold up interactive requests behind them
+  lines [backgroundPriority + 1]limitLine
 }
 
+type limitLine struct {
+  // Holds a single token, which is passed between waiting requests in the order they arrived
+  turn chan struct{}
+  // The number of requests waiting in line, including the one whose turn it is
+  waiting int
+  // When the rate limit resets, if the request whose turn it is has found it exhausted
+  resets time.Time
+}
+
+// The information known about a rate limit, common to all limiter implementations.
+type limitState struct {
+  current *uint
+  next    *uint
+  resets  time.Time
+}
+
+// A limiter which keeps its state in memory.
 type rateLimit struct {
   mxData     sync.Mutex
   mxNext     sync.Mutex
@@ -28,23 +95,23 @@ type rateLimit struct {
   //boolean flag is safe from instruction reordering because it is always protected by mxData
   resolving  bool
   resolved   chan struct{}
-  current    *uint
-  next       *uint
-  resets     time.Time
+  limitState
   assumeNext bool
 }
 
-func newSessions(assumeNextLimit bool) *sessions {
+func newSessions(newLimit limitFactory) *sessions {
   return &sessions{
-    cache:           make(map[string]*session),
-    assumeNextLimit: assumeNextLimit,
+    cache:    make(map[string]*session),
+    newLimit: newLimit,
   }
 }
 
-func newSession(assumeNextLimit bool) *session {
+func newSession(token string, newLimit limitFactory) *session {
   return &session{
-    limits:          make(map[string]*rateLimit),
-    assumeNextLimit: assumeNextLimit,
+    token:    token,
+    limits:   make(map[string]*queuedLimit),
+    raw:      make(map[string]bool),
+    newLimit: newLimit,
   }
 }
 
@@ -63,51 +130,172 @@ func (ses *sessions) get(token string) *session {
   if se, ok := ses.cache[token]; ok {
     return se
   }
-  se := newSession(ses.assumeNextLimit)
+  se := newSession(token, ses.newLimit)
   ses.cache[token] = se
+  if limits, ok := ses.saved[tokenDigest(token)]; ok {
+    for key, snapshot := range limits {
+      se.getLimit(key).restore(snapshot)
+    }
+    delete(ses.saved, tokenDigest(token))
+  }
   return se
 }
 
-func (se *session) getLimit(key string) *rateLimit {
+func newQueuedLimit(rl limiter) *queuedLimit {
+  ql := &queuedLimit{limiter: rl}
+  for i := range ql.lines {
+    ql.lines[i].turn = make(chan struct{}, 1)
+    ql.lines[i].turn <- struct{}{}
+  }
+  return ql
+}
+
+func (se *session) getLimit(key string) *queuedLimit {
+  se.mx.Lock()
+  defer se.mx.Unlock()
+  delete(se.raw, key)
+  if rl, ok := se.limits[key]; ok {
+    return rl
+  }
+  rl := newQueuedLimit(se.newLimit(se.token, key))
+  se.limits[key] = rl
+  return rl
+}
+
+// Returns the limit for the endpoint. Clients can make GetRaw requests to any number of paths, so only the first
+// maxRawLimits such paths which the proxy does not otherwise use get a limit which is kept. Requests to the rest
+// get a new limit each time, leaving Twitter to enforce the rate limit.
+func (se *session) endpointLimit(ep endpoint) *queuedLimit {
+  key := ep.limitKey()
+  if !ep.raw {
+    return se.getLimit(key)
+  }
   se.mx.Lock()
   defer se.mx.Unlock()
   if rl, ok := se.limits[key]; ok {
     return rl
   }
-  rl := newRateLimit(se.assumeNextLimit)
+  if len(se.raw) >= maxRawLimits {
+    return newQueuedLimit(newRateLimit(false))
+  }
+  rl := newQueuedLimit(se.newLimit(se.token, key))
   se.limits[key] = rl
+  se.raw[key] = true
   return rl
 }
 
+// Reports whether the client which made the request has asked for it to wait for the rate limit to reset.
+func waitForLimit(ctx context.Context) bool {
+  meta, ok := metadata.FromIncomingContext(ctx)
+  if !ok {
+    return false
+  }
+  vals := meta.Get(waitMetaKey)
+  return len(vals) > 0 && vals[0] == "true"
+}
+
+// Like use, but if the rate limit has been exhausted, waits in line until it resets rather than returning an
+// error. Waiting requests of the same priority are let through in the order they arrived. A rate limit error is
+// still returned if the limit will not reset before the context's deadline.
+func (ql *queuedLimit) wait(ctx context.Context, log logrus.FieldLogger, reserve uint) error {
+  line := &ql.lines[requestPriority(ctx)]
+
+  // There is no point joining the line if the limit is already known not to reset before the deadline
+  ql.mx.Lock()
+  if deadline, ok := ctx.Deadline(); ok && line.waiting > 0 && deadline.Before(line.resets) {
+    resets := line.resets
+    ql.mx.Unlock()
+    log.Info("Rate limit error")
+    return newRateLimitError(resets)
+  }
+  line.waiting++
+  ql.mx.Unlock()
+  defer func() {
+    ql.mx.Lock()
+    line.waiting--
+    if line.waiting == 0 {
+      line.resets = time.Time{}
+    }
+    ql.mx.Unlock()
+  }()
+
+  // Goroutines blocked on a channel are woken in the order they started waiting
+  select {
+  case <-line.turn:
+  case <-ctx.Done():
+    return ctx.Err()
+  }
+  defer func() {
+    line.turn <- struct{}{}
+  }()
+
+  for {
+    err := ql.use(ctx, log, reserve)
+    rlErr, ok := err.(rateLimitError)
+    if !ok {
+      return err
+    }
+    resets := rlErr.retry
+    if resets.IsZero() {
+      resets = time.Now().Add(rateLimitFallbackWait)
+    }
+    ql.mx.Lock()
+    line.resets = resets
+    ql.mx.Unlock()
+    if deadline, ok := ctx.Deadline(); ok && deadline.Before(resets) {
+      return err
+    }
+    if err := sleepContext(ctx, time.Until(resets)); err != nil {
+      return err
+    }
+  }
+}
+
+// Like use, but does not let the request go ahead of requests of the same priority which are waiting in line.
+// If any are, the request is only made if it is nobody's turn at the moment.
+func (ql *queuedLimit) try(ctx context.Context, log logrus.FieldLogger, reserve uint) error {
+  line := &ql.lines[requestPriority(ctx)]
+  ql.mx.Lock()
+  waiting, resets := line.waiting, line.resets
+  ql.mx.Unlock()
+  if waiting == 0 {
+    return ql.use(ctx, log, reserve)
+  }
+  select {
+  case <-line.turn:
+    defer func() {
+      line.turn <- struct{}{}
+    }()
+    return ql.use(ctx, log, reserve)
+  default:
+    log.Info("Rate limit error")
+    return newRateLimitError(resets)
+  }
+}
+
 func (rl *rateLimit) lockLow() {
-  log.Debug("Wait for low lock")
   rl.mxLow.Lock()
   rl.mxNext.Lock()
   rl.mxData.Lock()
   rl.mxNext.Unlock()
-  log.Debug("Acquire low lock")
 }
 
 func (rl *rateLimit) unlockLow() {
   rl.mxData.Unlock()
   rl.mxLow.Unlock()
-  log.Debug("Release low lock")
 }
 
 func (rl *rateLimit) lockHigh() {
-  log.Debug("Wait for high lock")
   rl.mxNext.Lock()
   rl.mxData.Lock()
   rl.mxNext.Unlock()
-  log.Debug("Acquire high lock")
 }
 
 func (rl *rateLimit) unlockHigh() {
   rl.mxData.Unlock()
-  log.Debug("Release high lock")
 }
 
-func (rl *rateLimit) use() error {
+func (rl *rateLimit) use(ctx context.Context, log logrus.FieldLogger, reserve uint) error {
   rl.lockLow()
   defer rl.unlockLow()
 
@@ -115,35 +303,19 @@ func (rl *rateLimit) use() error {
     log.Debug("Resolving! Must wait")
     rl.unlockLow()
     log.Debug("Wait for resolved message")
-    <-rl.resolved
+    select {
+    case <-rl.resolved:
+    case <-ctx.Done():
+      rl.lockLow()
+      return ctx.Err()
+    }
     log.Debug("Received resolved message")
     rl.resolved <- struct{}{}
     log.Debug("Return resolved message")
     rl.lockLow()
   }
 
-  now := time.Now()
-  resetsKnown := !rl.resets.IsZero()
-
-  if !resetsKnown && rl.current != nil && *rl.current == 0 {
-    log.Info("Escape from rate limit stuck condition")
-    rl.resets = now.Add(stuckResetTime) //Could be stuck forever otherwise!
-  } else if resetsKnown && now.After(rl.resets) {
-    if rl.next == nil {
-      if rl.current != nil && *rl.current == 0 {
-        rl.current = nil
-      }
-    } else {
-      if rl.current == nil {
-        rl.current = new(uint)
-      }
-      *rl.current = *rl.next
-      if !rl.assumeNext {
-        rl.next = nil
-      }
-    }
-    rl.resets = time.Time{}
-  }
+  rl.advance(log, time.Now(), rl.assumeNext)
 
   if rl.current == nil {
     log.Debug("Start resolving, take from resolved message channel")
@@ -151,8 +323,8 @@ func (rl *rateLimit) use() error {
     log.Debug("Received resolved message")
     rl.resolving = true
     return nil
-  } else if *rl.current > 0 {
-    log.WithField("old", *rl.current).WithField("new", *rl.current-1).Info("Update limit")
+  } else if *rl.current > reserve {
+    log.WithField("old", *rl.current).WithField("new", *rl.current-1).Debug("Update limit")
     *rl.current--
     return nil
   } else {
@@ -161,7 +333,7 @@ func (rl *rateLimit) use() error {
   }
 }
 
-func (rl *rateLimit) finish(current, next *uint, resets *time.Time, forceSync bool) {
+func (rl *rateLimit) finish(log logrus.FieldLogger, current, next *uint, resets *time.Time, forceSync bool) {
   rl.lockHigh()
   defer rl.unlockHigh()
 
@@ -171,24 +343,176 @@ func (rl *rateLimit) finish(current, next *uint, resets *time.Time, forceSync bo
     rl.resolved <- struct{}{}
   }
 
-  if current != nil && (forceSync || rl.current == nil) {
-    if rl.current == nil {
-      rl.current = new(uint)
+  rl.update(log, current, next, resets, forceSync)
+}
+
+// Moves on to the next rate limit window if the current one has ended.
+func (ls *limitState) advance(log logrus.FieldLogger, now time.Time, assumeNext bool) {
+  resetsKnown := !ls.resets.IsZero()
+
+  if !resetsKnown && ls.current != nil && *ls.current == 0 {
+    log.Info("Escape from rate limit stuck condition")
+    ls.resets = now.Add(stuckResetTime) //Could be stuck forever otherwise!
+  } else if resetsKnown && now.After(ls.resets) {
+    if ls.next == nil {
+      if ls.current != nil && *ls.current == 0 {
+        ls.current = nil
+      }
+    } else {
+      if ls.current == nil {
+        ls.current = new(uint)
+      }
+      *ls.current = *ls.next
+      if !assumeNext {
+        ls.next = nil
+      }
+    }
+    ls.resets = time.Time{}
+  }
+}
+
+// Updates the state with rate limit information received from Twitter.
+func (ls *limitState) update(log logrus.FieldLogger, current, next *uint, resets *time.Time, forceSync bool) {
+  if current != nil && (forceSync || ls.current == nil) {
+    if ls.current == nil {
+      ls.current = new(uint)
     }
-    *rl.current = *current
-    log.WithField("value", *current).Info("Get new current limit")
+    *ls.current = *current
+    log.WithField("value", *current).Debug("Get new current limit")
   }
 
   if next != nil {
-    if rl.next == nil {
-      rl.next = new(uint)
+    if ls.next == nil {
+      ls.next = new(uint)
+    }
+    *ls.next = *next
+    log.WithField("value", *next).Debug("Get new next limit")
+  }
+
+  if resets != nil && resets.After(ls.resets) {
+    ls.resets = *resets
+    log.WithField("value", *resets).Debug("Get new resets time")
+  }
+}
+
+func (ls limitState) pacing(now time.Time) time.Duration {
+  if ls.current == nil || ls.resets.IsZero() || !now.Before(ls.resets) {
+    return 0
+  }
+  if *ls.current == 0 {
+    return ls.resets.Sub(now)
+  }
+  return ls.resets.Sub(now) / time.Duration(*ls.current)
+}
+
+func (ls limitState) snapshot() LimitSnapshot {
+  var snapshot LimitSnapshot
+  if ls.current != nil {
+    snapshot.Current = new(uint)
+    *snapshot.Current = *ls.current
+  }
+  if ls.next != nil {
+    snapshot.Next = new(uint)
+    *snapshot.Next = *ls.next
+  }
+  snapshot.Resets = ls.resets
+  return snapshot
+}
+
+func (snapshot LimitSnapshot) state() limitState {
+  var ls limitState
+  if snapshot.Current != nil {
+    ls.current = new(uint)
+    *ls.current = *snapshot.Current
+  }
+  if snapshot.Next != nil {
+    ls.next = new(uint)
+    *ls.next = *snapshot.Next
+  }
+  ls.resets = snapshot.Resets
+  return ls
+}
+
+// Returns the interval between requests which would spread the remaining requests evenly over the time left
+// until the rate limit resets. If there is not enough information to calculate this, zero is returned.
+func (rl *rateLimit) pacing(now time.Time) time.Duration {
+  rl.lockHigh()
+  defer rl.unlockHigh()
+  return rl.limitState.pacing(now)
+}
+
+func (ses *sessions) snapshot() LimitSnapshots {
+  ses.mx.Lock()
+  defer ses.mx.Unlock()
+  snapshots := make(LimitSnapshots, len(ses.cache))
+  for token, se := range ses.cache {
+    if limits := se.snapshot(); len(limits) > 0 {
+      snapshots[token] = limits
     }
-    *rl.next = *next
-    log.WithField("value", *next).Info("Get new next limit")
   }
+  return snapshots
+}
 
-  if resets != nil && resets.After(rl.resets) {
-    rl.resets = *resets
-    log.WithField("value", *resets).Info("Get new resets time")
+// Returns the limits to persist, keyed by tokenDigest rather than by access token so that the tokens are not
+// written anywhere. Saved limits for tokens which have not been seen since they were loaded are kept until they
+// reset.
+func (ses *sessions) persistSnapshot() LimitSnapshots {
+  snapshots := make(LimitSnapshots)
+  for token, limits := range ses.snapshot() {
+    snapshots[tokenDigest(token)] = limits
+  }
+  ses.mx.Lock()
+  defer ses.mx.Unlock()
+  now := time.Now()
+  for digest, limits := range ses.saved {
+    for _, snapshot := range limits {
+      if snapshot.Resets.After(now) {
+        snapshots[digest] = limits
+        break
+      }
+    }
   }
+  return snapshots
+}
+
+// Restores limits returned by persistSnapshot. Since they are keyed by tokenDigest, each token's limits are
+// restored when its session is created.
+func (ses *sessions) restore(snapshots LimitSnapshots) {
+  ses.mx.Lock()
+  defer ses.mx.Unlock()
+  ses.saved = snapshots
+}
+
+// Leaves out the limits which have only been used by GetRaw requests, so that clients cannot add any number of
+// limits to the metrics and the saved state.
+func (se *session) snapshot() map[string]LimitSnapshot {
+  se.mx.Lock()
+  defer se.mx.Unlock()
+  limits := make(map[string]LimitSnapshot, len(se.limits))
+  for key, rl := range se.limits {
+    if se.raw[key] {
+      continue
+    }
+    if snapshot, ok := rl.snapshot(); ok {
+      limits[key] = snapshot
+    }
+  }
+  return limits
+}
+
+// Returns the current state of the rate limit, or false if nothing is known about it yet.
+func (rl *rateLimit) snapshot() (LimitSnapshot, bool) {
+  rl.lockHigh()
+  defer rl.unlockHigh()
+
+  if rl.current == nil && rl.next == nil && rl.resets.IsZero() {
+    return LimitSnapshot{}, false
+  }
+  return rl.limitState.snapshot(), true
+}
+
+func (rl *rateLimit) restore(snapshot LimitSnapshot) {
+  rl.lockHigh()
+  defer rl.unlockHigh()
+  rl.limitState = snapshot.state()
 }
@@ -0,0 +1,153 @@
+package proxy
+
+import (
+  "context"
+  "google.golang.org/grpc/metadata"
+  "testing"
+  "time"
+)
+
+func TestQueuedLimitWait(t *testing.T) {
+  const key = "singleton:statuses/show.json"
+  ses := newSessions(localLimits(false))
+  ql := ses.get("token").getLimit(key)
+
+  current, next := uint(0), uint(1)
+  resets := time.Now().Add(time.Millisecond * 100)
+  ql.restore(LimitSnapshot{Current: &current, Next: &next, Resets: resets})
+
+  // The limit will not reset before the deadline, so there is no point waiting
+  ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+  defer cancel()
+  start := time.Now()
+  if err := ql.wait(ctx, discardLogger(), 0); err == nil {
+    t.Fatalf("got no error, expected rate limit error")
+  } else if _, ok := err.(rateLimitError); !ok {
+    t.Fatalf("got %v, expected rate limit error", err)
+  }
+  if time.Since(start) > time.Millisecond*50 {
+    t.Errorf("waited %s before giving up", time.Since(start))
+  }
+
+  // Only one unit is available after the reset, so the first request in line gets it and the second is
+  // told that the limit has been exhausted again
+  first, second := make(chan error, 1), make(chan error, 1)
+  go func() {
+    first <- ql.wait(context.Background(), discardLogger(), 0)
+  }()
+  time.Sleep(time.Millisecond * 20)
+  go func() {
+    ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+    defer cancel()
+    second <- ql.wait(ctx, discardLogger(), 0)
+  }()
+
+  if err := <-first; err != nil {
+    t.Fatalf("first request: %v", err)
+  }
+  if time.Now().Before(resets) {
+    t.Errorf("first request finished waiting before the limit reset")
+  }
+  if err := <-second; err == nil {
+    t.Errorf("second request got no error, expected rate limit error")
+  } else if _, ok := err.(rateLimitError); !ok {
+    t.Errorf("second request got %v, expected rate limit error", err)
+  }
+
+  ctx, cancel = context.WithCancel(context.Background())
+  cancel()
+  if err := ql.wait(ctx, discardLogger(), 0); err != context.Canceled {
+    t.Errorf("got %v after cancelling, expected %v", err, context.Canceled)
+  }
+}
+
+func TestQueuedLimitLine(t *testing.T) {
+  const key = "singleton:statuses/show.json"
+  ses := newSessions(localLimits(false))
+  ql := ses.get("token").getLimit(key)
+
+  current, next := uint(0), uint(1)
+  resets := time.Now().Add(time.Millisecond * 200)
+  ql.restore(LimitSnapshot{Current: &current, Next: &next, Resets: resets})
+
+  first := make(chan error, 1)
+  go func() {
+    first <- ql.wait(context.Background(), discardLogger(), 0)
+  }()
+  time.Sleep(time.Millisecond * 20)
+
+  // The request in line already knows when the limit resets, so a request whose deadline is earlier fails
+  // without having to wait its turn
+  ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+  defer cancel()
+  start := time.Now()
+  if _, ok := ql.wait(ctx, discardLogger(), 0).(rateLimitError); !ok {
+    t.Errorf("expected rate limit error for request which cannot wait long enough")
+  }
+  if time.Since(start) > time.Millisecond*50 {
+    t.Errorf("waited %s before giving up", time.Since(start))
+  }
+
+  // Requests which do not wait must not take units ahead of requests in line
+  current, next = 5, 5
+  ql.restore(LimitSnapshot{Current: &current, Next: &next, Resets: resets})
+  if _, ok := ql.try(context.Background(), discardLogger(), 0).(rateLimitError); !ok {
+    t.Errorf("expected rate limit error for request which skipped the line")
+  }
+
+  if err := <-first; err != nil {
+    t.Fatalf("request in line: %v", err)
+  }
+  if err := ql.try(context.Background(), discardLogger(), 0); err != nil {
+    t.Errorf("request after the line emptied: %v", err)
+  }
+}
+
+func TestQueuedLimitPriority(t *testing.T) {
+  const key = "group:publish"
+  ses := newSessions(localLimits(true))
+  ql := ses.get("token").getLimit(key)
+
+  current, next := uint(2), uint(300)
+  ql.restore(LimitSnapshot{Current: &current, Next: &next, Resets: time.Now().Add(time.Minute)})
+
+  // The background request waits for the reset because the last units are held back for interactive requests
+  background := metadata.NewIncomingContext(context.Background(), metadata.Pairs(priorityMetaKey, "background"))
+  background, cancel := context.WithCancel(background)
+  defer cancel()
+  go ql.wait(background, discardLogger(), 2)
+  time.Sleep(time.Millisecond * 20)
+
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+  defer cancel()
+  if err := ql.wait(ctx, discardLogger(), 0); err != nil {
+    t.Errorf("interactive request behind background request: %v", err)
+  }
+}
+
+func TestLimitReserve(t *testing.T) {
+  const key = "group:publish"
+  ses := newSessions(localLimits(true))
+  rl := ses.get("token").getLimit(key)
+
+  current, next := uint(3), uint(300)
+  resets := time.Now().Add(time.Minute)
+  rl.restore(LimitSnapshot{Current: &current, Next: &next, Resets: resets})
+
+  if err := rl.use(context.Background(), discardLogger(), 2); err != nil {
+    t.Fatalf("background request above reserve: %v", err)
+  }
+  if err := rl.use(context.Background(), discardLogger(), 2); err == nil {
+    t.Fatalf("background request got no error, expected rate limit error")
+  } else if _, ok := err.(rateLimitError); !ok {
+    t.Fatalf("background request got %v, expected rate limit error", err)
+  }
+  for i := 0; i < 2; i++ {
+    if err := rl.use(context.Background(), discardLogger(), 0); err != nil {
+      t.Fatalf("interactive request %d: %v", i, err)
+    }
+  }
+  if err := rl.use(context.Background(), discardLogger(), 0); err == nil {
+    t.Errorf("interactive request got no error after limit was exhausted")
+  }
+}
@@ -0,0 +1,137 @@
+package proxy
+
+import (
+  "context"
+  "errors"
+  "github.com/sirupsen/logrus"
+  "time"
+)
+
+const (
+  sharedResolveTimeout = time.Second * 30
+  sharedResolvePoll    = time.Millisecond * 100
+)
+
+// The state of a rate limit which is shared between several instances of the proxy.
+type SharedLimitState struct {
+  LimitSnapshot
+  // While nothing is known about the rate limit, one instance makes a request to find out what it is and
+  // the other instances wait until either it finishes or this time passes.
+  ResolvingUntil time.Time `json:"resolving_until"`
+}
+
+// A SharedLimitStore holds rate limit state which is shared between several instances of the proxy, so that
+// they can all keep track of the same rate limits.
+type SharedLimitStore interface {
+  Get(token, key string) (SharedLimitState, error)
+  // Atomically applies fn to the state stored for the given access token and limit key. If fn returns an
+  // error, the stored state is left unchanged and the error is returned. fn may be called more than once.
+  Update(token, key string, fn func(state *SharedLimitState) error) error
+}
+
+// A limiter which keeps its state in a SharedLimitStore.
+type sharedLimit struct {
+  store      SharedLimitStore
+  token, key string
+  assumeNext bool
+  // Used to report errors from the store which do not happen while handling a request
+  log logrus.FieldLogger
+}
+
+var errSharedLimitResolving = errors.New("shared rate limit is being resolved by another request")
+
+func sharedLimits(store SharedLimitStore, assumeNext bool, log logrus.FieldLogger) limitFactory {
+  return func(token, key string) limiter {
+    return &sharedLimit{
+      store:      store,
+      token:      token,
+      key:        key,
+      assumeNext: assumeNext,
+      log:        log,
+    }
+  }
+}
+
+func (sl *sharedLimit) use(ctx context.Context, log logrus.FieldLogger, reserve uint) error {
+  for {
+    var rlErr error
+    err := sl.store.Update(sl.token, sl.key, func(shared *SharedLimitState) error {
+      rlErr = nil
+      now := time.Now()
+      ls := shared.state()
+      ls.advance(log, now, sl.assumeNext)
+      if ls.current == nil {
+        if now.Before(shared.ResolvingUntil) {
+          return errSharedLimitResolving
+        }
+        shared.ResolvingUntil = now.Add(sharedResolveTimeout)
+      } else if *ls.current > reserve {
+        log.WithField("old", *ls.current).WithField("new", *ls.current-1).Debug("Update limit")
+        *ls.current--
+      } else {
+        // The state is still saved, since advance may have given the limit a reset time
+        rlErr = newRateLimitError(ls.resets)
+      }
+      shared.LimitSnapshot = ls.snapshot()
+      return nil
+    })
+    if err == nil && rlErr != nil {
+      log.Info("Rate limit error")
+      return rlErr
+    }
+    if err != errSharedLimitResolving {
+      return err
+    }
+    if err := sleepContext(ctx, sharedResolvePoll); err != nil {
+      return err
+    }
+  }
+}
+
+func (sl *sharedLimit) finish(log logrus.FieldLogger, current, next *uint, resets *time.Time, forceSync bool) {
+  err := sl.store.Update(sl.token, sl.key, func(shared *SharedLimitState) error {
+    ls := shared.state()
+    ls.update(log, current, next, resets, forceSync)
+    shared.LimitSnapshot = ls.snapshot()
+    shared.ResolvingUntil = time.Time{}
+    return nil
+  })
+  if err != nil {
+    log.WithError(err).Error("Failed to update shared rate limit")
+  }
+}
+
+func (sl *sharedLimit) pacing(now time.Time) time.Duration {
+  shared, err := sl.store.Get(sl.token, sl.key)
+  if err != nil {
+    sl.log.WithError(err).Error("Failed to get shared rate limit")
+    return 0
+  }
+  return shared.state().pacing(now)
+}
+
+func (sl *sharedLimit) snapshot() (LimitSnapshot, bool) {
+  shared, err := sl.store.Get(sl.token, sl.key)
+  if err != nil {
+    sl.log.WithError(err).Error("Failed to get shared rate limit")
+    return LimitSnapshot{}, false
+  }
+  if shared.Current == nil && shared.Next == nil && shared.Resets.IsZero() {
+    return LimitSnapshot{}, false
+  }
+  return shared.LimitSnapshot, true
+}
+
+// Only overwrites the shared state if nothing is known about the rate limit yet, since other instances may
+// have more recent information.
+func (sl *sharedLimit) restore(snapshot LimitSnapshot) {
+  err := sl.store.Update(sl.token, sl.key, func(shared *SharedLimitState) error {
+    if shared.Current == nil && shared.Resets.IsZero() {
+      shared.LimitSnapshot = snapshot
+    }
+    return nil
+  })
+  if err != nil {
+    sl.log.WithError(err).Error("Failed to restore shared rate limit")
+  }
+}
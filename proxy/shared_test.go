@@ -0,0 +1,117 @@
+package proxy
+
+import (
+  "context"
+  "sync"
+  "testing"
+  "time"
+)
+
+// An in-process SharedLimitStore standing in for Redis.
+type memorySharedLimitStore struct {
+  mx     sync.Mutex
+  states map[string]SharedLimitState
+}
+
+func newMemorySharedLimitStore() *memorySharedLimitStore {
+  return &memorySharedLimitStore{states: make(map[string]SharedLimitState)}
+}
+
+func (store *memorySharedLimitStore) Get(token, key string) (SharedLimitState, error) {
+  store.mx.Lock()
+  defer store.mx.Unlock()
+  return store.states[token+":"+key], nil
+}
+
+func (store *memorySharedLimitStore) Update(token, key string, fn func(state *SharedLimitState) error) error {
+  store.mx.Lock()
+  defer store.mx.Unlock()
+  state := store.states[token+":"+key]
+  if err := fn(&state); err != nil {
+    return err
+  }
+  store.states[token+":"+key] = state
+  return nil
+}
+
+func TestSharedLimitBetweenInstances(t *testing.T) {
+  const key = "singleton:statuses/show.json"
+  store := newMemorySharedLimitStore()
+  first := newSessions(sharedLimits(store, true, discardLogger()))
+  second := newSessions(sharedLimits(store, true, discardLogger()))
+
+  // Nothing is known about the limit yet, so the first request is allowed through to resolve it
+  if err := first.get("token").getLimit(key).use(context.Background(), discardLogger(), 0); err != nil {
+    t.Fatalf("resolving request: %v", err)
+  }
+  current, next := uint(2), uint(15)
+  resets := time.Now().Add(time.Minute)
+  first.get("token").getLimit(key).finish(discardLogger(), &current, &next, &resets, false)
+
+  for i := 0; i < 2; i++ {
+    if err := second.get("token").getLimit(key).use(context.Background(), discardLogger(), 0); err != nil {
+      t.Fatalf("request %d: %v", i, err)
+    }
+  }
+
+  err := first.get("token").getLimit(key).use(context.Background(), discardLogger(), 0)
+  rlErr, ok := err.(rateLimitError)
+  if !ok {
+    t.Fatalf("got %v, expected rate limit error", err)
+  }
+  if !rlErr.retry.Equal(resets) {
+    t.Errorf("got retry time %s, expected %s", rlErr.retry, resets)
+  }
+}
+
+func TestSharedLimitResets(t *testing.T) {
+  const key = "singleton:statuses/show.json"
+  store := newMemorySharedLimitStore()
+  ses := newSessions(sharedLimits(store, true, discardLogger()))
+
+  current, next := uint(0), uint(15)
+  resets := time.Now().Add(-time.Second)
+  store.Update("token", key, func(state *SharedLimitState) error {
+    state.LimitSnapshot = LimitSnapshot{Current: &current, Next: &next, Resets: resets}
+    return nil
+  })
+
+  if err := ses.get("token").getLimit(key).use(context.Background(), discardLogger(), 0); err != nil {
+    t.Fatalf("request after reset: %v", err)
+  }
+  state, _ := store.Get("token", key)
+  if state.Current == nil || *state.Current != next-1 {
+    t.Errorf("got current %v, expected %d", state.Current, next-1)
+  }
+}
+
+func TestSharedLimitStuck(t *testing.T) {
+  const key = "singleton:statuses/show.json"
+  store := newMemorySharedLimitStore()
+  ses := newSessions(sharedLimits(store, true, discardLogger()))
+
+  // A 429 without a reset time leaves the limit exhausted with no way of knowing when it resets
+  current := uint(0)
+  store.Update("token", key, func(state *SharedLimitState) error {
+    state.LimitSnapshot = LimitSnapshot{Current: &current}
+    return nil
+  })
+
+  if _, ok := ses.get("token").getLimit(key).use(context.Background(), discardLogger(), 0).(rateLimitError); !ok {
+    t.Fatalf("expected rate limit error")
+  }
+  if state, _ := store.Get("token", key); state.Resets.IsZero() {
+    t.Errorf("fallback reset time was not saved")
+  }
+
+  // Requests waiting for another instance to resolve the limit give up when their context is done
+  store.Update("token", key, func(state *SharedLimitState) error {
+    *state = SharedLimitState{ResolvingUntil: time.Now().Add(time.Minute)}
+    return nil
+  })
+  ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+  defer cancel()
+  if err := ses.get("token").getLimit(key).use(ctx, discardLogger(), 0); err != context.DeadlineExceeded {
+    t.Errorf("got %v, expected %v", err, context.DeadlineExceeded)
+  }
+}
@@ -0,0 +1,215 @@
+package proxy
+
+import (
+  "context"
+  "errors"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "google.golang.org/genproto/googleapis/rpc/errdetails"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/codes"
+  "google.golang.org/grpc/metadata"
+  "google.golang.org/grpc/status"
+  "google.golang.org/protobuf/protoadapt"
+  "google.golang.org/protobuf/types/known/durationpb"
+  "net/url"
+  "strconv"
+  "time"
+)
+
+// Clients set this metadata key to "status" to receive errors as gRPC statuses rather than as responses
+// containing an Error, or to "response" to receive them as responses even if the proxy defaults to statuses.
+const errorsMetaKey = "goldcrest-errors"
+
+var statusCodes = map[pb.Error_Code]codes.Code{
+  pb.Error_RATE_LIMIT:     codes.ResourceExhausted,
+  pb.Error_QUOTA_EXCEEDED: codes.ResourceExhausted,
+  pb.Error_TWITTER_ERROR:  codes.Unavailable,
+  pb.Error_BAD_REQUEST:    codes.InvalidArgument,
+  pb.Error_BAD_RESPONSE:   codes.Internal,
+  pb.Error_NOT_FOUND:      codes.NotFound,
+  pb.Error_UNAUTHORIZED:   codes.Unauthenticated,
+  pb.Error_FORBIDDEN:      codes.PermissionDenied,
+  pb.Error_DUPLICATE:      codes.AlreadyExists,
+}
+
+// Implemented by every response which may contain an Error.
+type errorResponse interface {
+  GetError() *pb.Error
+}
+
+// Converts errors into gRPC statuses for clients which have asked for them. The handlers always report errors
+// in their responses; this is done afterwards so that they do not need to know which the client wants.
+type statusErrors struct {
+  // Whether clients which do not say which they want receive statuses
+  byDefault bool
+}
+
+func (se statusErrors) enabled(ctx context.Context) bool {
+  if meta, ok := metadata.FromIncomingContext(ctx); ok {
+    if vals := meta.Get(errorsMetaKey); len(vals) > 0 {
+      switch vals[0] {
+      case "status":
+        return true
+      case "response":
+        return false
+      }
+    }
+  }
+  return se.byDefault
+}
+
+// Returns a status for the error, with the Error attached as a detail so that nothing is lost. The reset time
+// of rate limit and quota errors is taken out of the retry metadata and attached as a RetryInfo instead.
+func errorStatus(errMsg *pb.Error, metas ...metadata.MD) error {
+  code, ok := statusCodes[errMsg.GetCode()]
+  if !ok {
+    code = codes.Unknown
+  }
+  st := status.New(code, errMsg.GetMessage())
+  details := []protoadapt.MessageV1{errMsg}
+  for _, meta := range metas {
+    vals := meta.Get("retry")
+    if len(vals) == 0 {
+      continue
+    }
+    meta.Delete("retry")
+    if retryUnix, err := strconv.ParseInt(vals[0], 10, 64); err == nil {
+      delay := time.Until(time.Unix(retryUnix, 0))
+      if delay < 0 {
+        delay = 0
+      }
+      details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+    }
+    break
+  }
+  if withDetails, err := st.WithDetails(details...); err == nil {
+    st = withDetails
+  }
+  return st.Err()
+}
+
+// Gives errors which did not come from the proxy a more useful code than Unknown where possible.
+func otherErrorStatus(err error) error {
+  if _, ok := status.FromError(err); ok {
+    return err
+  }
+  if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+    return status.FromContextError(err).Err()
+  }
+  var urlErr *url.Error
+  if errors.As(err, &urlErr) {
+    return status.Error(codes.Unavailable, err.Error())
+  }
+  return err
+}
+
+func (se statusErrors) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+  if !se.enabled(ctx) {
+    return handler(ctx, req)
+  }
+  // Headers are held back until the handler returns, so that the retry metadata can be taken out of them
+  stream := &heldHeaderStream{ServerTransportStream: grpc.ServerTransportStreamFromContext(ctx)}
+  resp, err := handler(grpc.NewContextWithServerTransportStream(ctx, stream), req)
+  if err != nil {
+    err = otherErrorStatus(err)
+  } else if errResp, ok := resp.(errorResponse); ok && errResp.GetError() != nil {
+    resp, err = nil, errorStatus(errResp.GetError(), stream.header)
+  }
+  if len(stream.header) > 0 {
+    if setErr := grpc.SetHeader(ctx, stream.header); setErr != nil {
+      return nil, setErr
+    }
+  }
+  return resp, err
+}
+
+func (se statusErrors) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+  if !se.enabled(stream.Context()) {
+    return handler(srv, stream)
+  }
+  held := newStatusServerStream(stream)
+  err := handler(srv, held)
+  if held.err != nil {
+    err = held.err
+  } else if err != nil {
+    err = otherErrorStatus(err)
+  }
+  if flushErr := held.flushHeader(); flushErr != nil && err == nil {
+    err = flushErr
+  }
+  if len(held.trailer) > 0 {
+    stream.SetTrailer(held.trailer)
+  }
+  return err
+}
+
+// Holds on to the headers set by a handler, whether through grpc.SetHeader or grpc.SendHeader.
+type heldHeaderStream struct {
+  grpc.ServerTransportStream
+  header metadata.MD
+}
+
+func (s *heldHeaderStream) SetHeader(md metadata.MD) error {
+  s.header = metadata.Join(s.header, md)
+  return nil
+}
+
+func (s *heldHeaderStream) SendHeader(md metadata.MD) error {
+  return s.SetHeader(md)
+}
+
+// Turns the Error which the handler sends as the last message of a stream into a status, which the handler then
+// returns when it fails to send it. Headers and trailers are held back so that the retry metadata can be taken
+// out of them; headers are sent along with the first message that gets through.
+type statusServerStream struct {
+  grpc.ServerStream
+  ctx     context.Context
+  headers *heldHeaderStream
+  trailer metadata.MD
+  err     error
+}
+
+func newStatusServerStream(stream grpc.ServerStream) *statusServerStream {
+  headers := &heldHeaderStream{ServerTransportStream: grpc.ServerTransportStreamFromContext(stream.Context())}
+  return &statusServerStream{
+    ServerStream: stream,
+    ctx:          grpc.NewContextWithServerTransportStream(stream.Context(), headers),
+    headers:      headers,
+  }
+}
+
+func (s *statusServerStream) Context() context.Context {
+  return s.ctx
+}
+
+func (s *statusServerStream) SetHeader(md metadata.MD) error {
+  return s.headers.SetHeader(md)
+}
+
+func (s *statusServerStream) SendHeader(md metadata.MD) error {
+  return s.headers.SetHeader(md)
+}
+
+func (s *statusServerStream) SetTrailer(md metadata.MD) {
+  s.trailer = metadata.Join(s.trailer, md)
+}
+
+func (s *statusServerStream) flushHeader() error {
+  if len(s.headers.header) == 0 {
+    return nil
+  }
+  header := s.headers.header
+  s.headers.header = nil
+  return s.ServerStream.SetHeader(header)
+}
+
+func (s *statusServerStream) SendMsg(m interface{}) error {
+  if errResp, ok := m.(errorResponse); ok && errResp.GetError() != nil {
+    s.err = errorStatus(errResp.GetError(), s.headers.header, s.trailer)
+    return s.err
+  }
+  if err := s.flushHeader(); err != nil {
+    return err
+  }
+  return s.ServerStream.SendMsg(m)
+}
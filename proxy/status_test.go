@@ -0,0 +1,103 @@
+package proxy
+
+import (
+  "context"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "google.golang.org/genproto/googleapis/rpc/errdetails"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/codes"
+  "google.golang.org/grpc/credentials/insecure"
+  "google.golang.org/grpc/metadata"
+  "google.golang.org/grpc/status"
+  "google.golang.org/grpc/test/bufconn"
+  "net"
+  "net/http"
+  "net/http/httptest"
+  "strconv"
+  "testing"
+  "time"
+)
+
+func TestStatusErrors(t *testing.T) {
+  resets := time.Now().Add(time.Minute)
+  twitter := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set(headerRateLimitReset, strconv.FormatInt(resets.Unix(), 10))
+    w.WriteHeader(http.StatusTooManyRequests)
+  }))
+  defer twitter.Close()
+
+  p, err := NewProxy(nil, Config{
+    TwitterTimeout:  time.Second,
+    TwitterProtocol: "http",
+    TwitterURL:      twitter.Listener.Addr().String(),
+  })
+  if err != nil {
+    t.Fatalf("creating proxy: %v", err)
+  }
+  defer p.Close()
+  client := serveProxy(t, p)
+
+  // Errors are reported in the response by default
+  resp, err := client.GetTweet(context.Background(), &pb.TweetRequest{Id: 1})
+  if err != nil {
+    t.Fatalf("got error %v, expected a response", err)
+  }
+  if resp.GetError().GetCode() != pb.Error_RATE_LIMIT {
+    t.Errorf("got response %v, expected a rate limit error", resp)
+  }
+
+  ctx := metadata.AppendToOutgoingContext(context.Background(), errorsMetaKey, "status")
+  var header metadata.MD
+  _, err = client.GetTweet(ctx, &pb.TweetRequest{Id: 1}, grpc.Header(&header))
+  st := status.Convert(err)
+  if st.Code() != codes.ResourceExhausted {
+    t.Fatalf("got status %v, expected %v", st.Code(), codes.ResourceExhausted)
+  }
+  var retryInfo *errdetails.RetryInfo
+  var errMsg *pb.Error
+  for _, detail := range st.Details() {
+    switch detail := detail.(type) {
+    case *errdetails.RetryInfo:
+      retryInfo = detail
+    case *pb.Error:
+      errMsg = detail
+    }
+  }
+  if retryInfo == nil {
+    t.Errorf("no RetryInfo in status details")
+  } else if delay := retryInfo.GetRetryDelay().AsDuration(); delay <= 0 || delay > time.Minute {
+    t.Errorf("got retry delay %v, expected at most a minute", delay)
+  }
+  if errMsg.GetCode() != pb.Error_RATE_LIMIT {
+    t.Errorf("got error detail %v, expected a rate limit error", errMsg)
+  }
+  if vals := header.Get("retry"); len(vals) > 0 {
+    t.Errorf("got retry header %v alongside RetryInfo", vals)
+  }
+  if vals := header.Get(requestIDMetaKey); len(vals) != 1 {
+    t.Errorf("got request id header %v, expected one id", vals)
+  }
+}
+
+// Serves the proxy over an in-memory connection until the test finishes, and returns a client connected to it.
+func serveProxy(t *testing.T, p *Proxy) pb.TwitterClient {
+  listener := bufconn.Listen(1 << 20)
+  server := grpc.NewServer(p.ServerOptions()...)
+  pb.RegisterTwitterServer(server, p)
+  go server.Serve(listener)
+  t.Cleanup(server.Stop)
+
+  conn, err := grpc.NewClient("passthrough:///bufconn",
+    grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+      return listener.DialContext(ctx)
+    }),
+    grpc.WithTransportCredentials(insecure.NewCredentials()),
+  )
+  if err != nil {
+    t.Fatalf("dialling proxy: %v", err)
+  }
+  t.Cleanup(func() {
+    conn.Close()
+  })
+  return pb.NewTwitterClient(conn)
+}
@@ -0,0 +1,229 @@
+package proxy
+
+import (
+  "context"
+  "errors"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "github.com/pantonshire/goldcrest/proxy/oauth"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/codes"
+  "google.golang.org/grpc/status"
+  "time"
+)
+
+const (
+  streamMinPollInterval = time.Second * 5
+)
+
+type tweetPoller func(tlOpts timelineOptions) (model.Timeline, error)
+
+func (p Proxy) StreamTweets(req *pb.StreamTweetsRequest, stream pb.Twitter_StreamTweetsServer) (err error) {
+  ctx, done := p.streamContext(stream)
+  defer func() {
+    err = p.streamErr(done(), err)
+  }()
+
+  var (
+    ep     endpoint
+    auth   oauth.AuthPair
+    tlOpts timelineOptions
+    poll   tweetPoller
+  )
+
+  switch source := req.GetSource().(type) {
+  case *pb.StreamTweetsRequest_Search:
+    ep = searchEndpoint
+    auth = desAuth(source.Search.GetAuth())
+    tlOpts = desTimelineOptions(source.Search.GetTimelineOptions())
+    poll = p.searchPoller(ctx, auth, reserSearchParams(source.Search))
+  case *pb.StreamTweetsRequest_MentionTimeline:
+    ep = mentionTimelineEndpoint
+    auth = desAuth(source.MentionTimeline.GetAuth())
+    tlOpts = desTimelineOptions(source.MentionTimeline.GetTimelineOptions())
+    poll = p.timelinePoller(ctx, ep, auth, oauth.NewParams())
+  case *pb.StreamTweetsRequest_UserTimeline:
+    ep = userTimelineEndpoint
+    auth = desAuth(source.UserTimeline.GetAuth())
+    tlOpts = desTimelineOptions(source.UserTimeline.GetTimelineOptions())
+    poll = p.timelinePoller(ctx, ep, auth, reserUserTimelineParams(source.UserTimeline))
+  default:
+    return status.Error(codes.InvalidArgument, "no tweet source specified")
+  }
+
+  // There will never be any new tweets if there is an upper bound on the id
+  tlOpts.maxID = nil
+
+  minInterval := time.Duration(req.GetMinIntervalSeconds()) * time.Second
+  if minInterval < streamMinPollInterval {
+    minInterval = streamMinPollInterval
+  }
+
+  for {
+    tweets, complete, err := pollNewTweets(tlOpts, poll)
+    if err != nil {
+      if err := awaitRateLimit(ctx, err); err != nil {
+        if errMsg, errMeta := serError(err); errMsg != nil {
+          if errMeta != nil {
+            stream.SetTrailer(errMeta)
+          }
+          return stream.Send(&pb.TweetResponse{Response: &pb.TweetResponse_Error{Error: errMsg}})
+        }
+        return err
+      }
+      continue
+    }
+    if !complete {
+      p.tc.logger(ctx).WithField("endpoint", ep.path).Warn("Stream ran out of rate limit before fetching every new tweet, so some were skipped")
+    }
+
+    // Twitter returns the newest tweets first, so send them in reverse order
+    for i := len(tweets) - 1; i >= 0; i-- {
+      if err := stream.Send(&pb.TweetResponse{Response: &pb.TweetResponse_Tweet{Tweet: serTweet(tweets[i])}}); err != nil {
+        return err
+      }
+      if tlOpts.minID == nil || tweets[i].ID >= *tlOpts.minID {
+        tlOpts.minID = new(uint64)
+        *tlOpts.minID = tweets[i].ID + 1
+      }
+    }
+
+    wait := p.tc.pacing(ep, auth.Public.Token)
+    if wait < minInterval {
+      wait = minInterval
+    }
+    if err := sleepContext(ctx, wait); err != nil {
+      return err
+    }
+  }
+}
+
+// Fetches the tweets newer than opts.minID. If more tweets have arrived since the last poll than fit on one
+// page, older pages are fetched until the gap is closed so that no tweets are skipped. If the rate limit runs
+// out before then, the tweets fetched so far are returned, and complete is false.
+func pollNewTweets(opts timelineOptions, fetch tweetPoller) (tweets model.Timeline, complete bool, err error) {
+  page, err := fetch(opts)
+  if err != nil {
+    return nil, false, err
+  }
+  if opts.minID == nil {
+    return page, true, nil
+  }
+
+  seen := make(map[uint64]bool)
+  for {
+    var added int
+    oldest := ^uint64(0)
+    for _, tweet := range page {
+      if tweet.ID < oldest {
+        oldest = tweet.ID
+      }
+      if !seen[tweet.ID] {
+        seen[tweet.ID] = true
+        tweets = append(tweets, tweet)
+        added++
+      }
+    }
+
+    // A page which isn't full, or which reaches the newest tweet from the last poll, closes the gap
+    if added == 0 || (opts.count > 0 && uint(len(page)) < opts.count) || oldest <= *opts.minID {
+      return tweets, true, nil
+    }
+
+    opts.maxID = new(uint64)
+    *opts.maxID = oldest - 1
+    if page, err = fetch(opts); err != nil {
+      if _, ok := err.(rateLimitError); ok {
+        return tweets, false, nil
+      }
+      return nil, false, err
+    }
+  }
+}
+
+// Returns a context for the stream which is also cancelled when the proxy ends its streams. done must be called
+// once the stream is over, and reports whether the stream was ended by the proxy.
+func (p Proxy) streamContext(stream grpc.ServerStream) (ctx context.Context, done func() bool) {
+  ctx, cancel := context.WithCancel(stream.Context())
+  stop := context.AfterFunc(p.streams, cancel)
+  return ctx, func() bool {
+    ended := !stop() && stream.Context().Err() == nil
+    cancel()
+    return ended
+  }
+}
+
+// Tells the client of a stream which the proxy ended that it is shutting down, rather than reporting that the
+// stream was cancelled.
+func (p Proxy) streamErr(ended bool, err error) error {
+  if ended && errors.Is(err, context.Canceled) {
+    return status.Error(codes.Unavailable, "server is shutting down")
+  }
+  return err
+}
+
+func (p Proxy) timelinePoller(ctx context.Context, ep endpoint, auth oauth.AuthPair, params oauth.Params) tweetPoller {
+  return func(tlOpts timelineOptions) (model.Timeline, error) {
+    query := tlOpts.ser()
+    query.Extend(params)
+    var tweets model.Timeline
+    if err := p.tc.standardRequest(ctx, ep, auth, query, nil, &tweets); err != nil {
+      return nil, err
+    }
+    return tweets, nil
+  }
+}
+
+func (p Proxy) searchPoller(ctx context.Context, auth oauth.AuthPair, params oauth.Params) tweetPoller {
+  return func(tlOpts timelineOptions) (model.Timeline, error) {
+    result, err := p.searchPage(ctx, auth, params, tlOpts)
+    if err != nil {
+      return nil, err
+    }
+    return result.Statuses, nil
+  }
+}
+
+func (p Proxy) searchPage(ctx context.Context, auth oauth.AuthPair, params oauth.Params, tlOpts timelineOptions) (model.SearchResult, error) {
+  query := tlOpts.ser()
+  query.Extend(params)
+  var result model.SearchResult
+  if err := p.tc.standardRequest(ctx, searchEndpoint, auth, query, nil, &result); err != nil {
+    return model.SearchResult{}, err
+  }
+  return result, nil
+}
+
+// Fetches pages one after another, starting from the page given by opts, until the last page has been
+// fetched. fetch returns the cursor of the next page, which is zero if there are no more pages. If the rate
+// limit is hit, the page is fetched again once it resets.
+func walkCursor(ctx context.Context, opts cursorOptions, fetch func(opts cursorOptions) (int64, error)) error {
+  for {
+    next, err := fetch(opts)
+    if err != nil {
+      if err := awaitRateLimit(ctx, err); err != nil {
+        return err
+      }
+      continue
+    }
+    if next == 0 {
+      return nil
+    }
+    opts.cursor = new(int64)
+    *opts.cursor = next
+  }
+}
+
+// If err is a rate limit error, blocks until the rate limit resets and returns nil so that the request can
+// be made again. Otherwise, err is returned.
+func awaitRateLimit(ctx context.Context, err error) error {
+  rlErr, ok := err.(rateLimitError)
+  if !ok {
+    return err
+  }
+  wait := rateLimitFallbackWait
+  if !rlErr.retry.IsZero() {
+    wait = time.Until(rlErr.retry)
+  }
+  return sleepContext(ctx, wait)
+}
@@ -0,0 +1,92 @@
+package proxy
+
+import (
+  "context"
+  "fmt"
+  pb "github.com/pantonshire/goldcrest/protocol"
+  "github.com/pantonshire/goldcrest/proxy/model"
+  "google.golang.org/grpc/codes"
+  "google.golang.org/grpc/status"
+  "net/http"
+  "net/http/httptest"
+  "testing"
+  "time"
+)
+
+func TestEndStreams(t *testing.T) {
+  polled := make(chan struct{}, 1)
+  twitter := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `[]`)
+    select {
+    case polled <- struct{}{}:
+    default:
+    }
+  }))
+  defer twitter.Close()
+
+  p, err := NewProxy(nil, Config{
+    TwitterTimeout:  time.Second,
+    TwitterProtocol: "http",
+    TwitterURL:      twitter.Listener.Addr().String(),
+  })
+  if err != nil {
+    t.Fatalf("creating proxy: %v", err)
+  }
+  defer p.Close()
+  client := serveProxy(t, p)
+
+  ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+  defer cancel()
+  stream, err := client.StreamTweets(ctx, &pb.StreamTweetsRequest{
+    Source: &pb.StreamTweetsRequest_UserTimeline{UserTimeline: &pb.UserTimelineRequest{}},
+  })
+  if err != nil {
+    t.Fatalf("opening stream: %v", err)
+  }
+  <-polled
+
+  // The stream is waiting to poll again, and would otherwise only end when the client goes away
+  p.EndStreams()
+  if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+    t.Errorf("got error %v, expected the stream to end with %v", err, codes.Unavailable)
+  }
+}
+
+func TestPollNewTweets(t *testing.T) {
+  // Tweets 1 to 10 exist, and the last poll saw up to tweet 2
+  fetch, calls := fakeTimeline(10, 100)
+  minID := uint64(3)
+  opts := timelineOptions{count: 3, minID: &minID}
+  since := func(opts timelineOptions) (model.Timeline, error) {
+    tweets, err := fetch(opts)
+    var newer model.Timeline
+    for _, tweet := range tweets {
+      if tweet.ID >= *opts.minID {
+        newer = append(newer, tweet)
+      }
+    }
+    return newer, err
+  }
+
+  tweets, complete, err := pollNewTweets(opts, since)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if !complete || len(tweets) != 8 || tweets[0].ID != 10 || tweets[7].ID != 3 {
+    t.Errorf("got %v, complete %v, expected tweets 10 to 3", tweets, complete)
+  }
+  // Each page after the first repeats a tweet from the one before, so four pages are needed
+  if *calls != 4 {
+    t.Errorf("made %d requests, expected 4", *calls)
+  }
+
+  // The rate limit runs out after the first page, so the gap can't be closed
+  fetch, _ = fakeTimeline(10, 1)
+  tweets, complete, err = pollNewTweets(opts, since)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if complete || len(tweets) != 3 {
+    t.Errorf("got %v, complete %v, expected 3 tweets and an incomplete poll", tweets, complete)
+  }
+}
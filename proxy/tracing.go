@@ -0,0 +1,93 @@
+package proxy
+
+import (
+  "context"
+  "go.opentelemetry.io/otel/attribute"
+  "go.opentelemetry.io/otel/codes"
+  "go.opentelemetry.io/otel/propagation"
+  "go.opentelemetry.io/otel/trace"
+  "google.golang.org/grpc"
+  "google.golang.org/grpc/metadata"
+  "google.golang.org/grpc/status"
+)
+
+const tracerName = "github.com/pantonshire/goldcrest/proxy"
+
+// Trace context is read from incoming requests in the W3C Trace Context format.
+var tracePropagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// Allows trace context to be read from gRPC metadata.
+type metadataCarrier metadata.MD
+
+func (mc metadataCarrier) Get(key string) string {
+  if vals := metadata.MD(mc).Get(key); len(vals) > 0 {
+    return vals[0]
+  }
+  return ""
+}
+
+func (mc metadataCarrier) Set(key, val string) {
+  metadata.MD(mc).Set(key, val)
+}
+
+func (mc metadataCarrier) Keys() []string {
+  keys := make([]string, 0, len(mc))
+  for key := range mc {
+    keys = append(keys, key)
+  }
+  return keys
+}
+
+// Starts a span covering the handling of an RPC, continuing the trace of the client which made it if there is
+// one.
+func startRPCSpan(ctx context.Context, tracer trace.Tracer, method string) (context.Context, trace.Span) {
+  if meta, ok := metadata.FromIncomingContext(ctx); ok {
+    ctx = tracePropagator.Extract(ctx, metadataCarrier(meta))
+  }
+  return tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+    attribute.String("rpc.system", "grpc"),
+    attribute.String("rpc.method", method),
+  ))
+}
+
+func endRPCSpan(span trace.Span, err error) {
+  span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+  endSpan(span, err)
+}
+
+func tracingUnaryInterceptor(tracer trace.Tracer) grpc.UnaryServerInterceptor {
+  return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+    ctx, span := startRPCSpan(ctx, tracer, info.FullMethod)
+    resp, err := handler(ctx, req)
+    endRPCSpan(span, err)
+    return resp, err
+  }
+}
+
+func tracingStreamInterceptor(tracer trace.Tracer) grpc.StreamServerInterceptor {
+  return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+    ctx, span := startRPCSpan(stream.Context(), tracer, info.FullMethod)
+    err := handler(srv, contextServerStream{ServerStream: stream, ctx: ctx})
+    endRPCSpan(span, err)
+    return err
+  }
+}
+
+// Replaces the context of a stream, so that interceptors can attach values to it.
+type contextServerStream struct {
+  grpc.ServerStream
+  ctx context.Context
+}
+
+func (stream contextServerStream) Context() context.Context {
+  return stream.ctx
+}
+
+// Records err on the span if it is not nil, then ends the span.
+func endSpan(span trace.Span, err error) {
+  if err != nil {
+    span.RecordError(err)
+    span.SetStatus(codes.Error, err.Error())
+  }
+  span.End()
+}
@@ -0,0 +1,53 @@
+package proxy
+
+import (
+  "context"
+  "fmt"
+  sdktrace "go.opentelemetry.io/otel/sdk/trace"
+  "go.opentelemetry.io/otel/sdk/trace/tracetest"
+  "google.golang.org/grpc/metadata"
+  "net/http"
+  "net/http/httptest"
+  "testing"
+  "time"
+)
+
+func TestTracing(t *testing.T) {
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"id": 123}`)
+  }))
+  defer server.Close()
+
+  recorder := tracetest.NewSpanRecorder()
+  provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  tc.tracer = provider.Tracer(tracerName)
+  auth, query := reserTweetRequest(nil)
+
+  const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+  incoming := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+    "traceparent", "00-"+traceID+"-00f067aa0ba902b7-01",
+  ))
+  ctx, span := startRPCSpan(incoming, tc.tracer, "/Twitter/GetTweet")
+  var tweet struct {
+    ID uint64 `json:"id"`
+  }
+  err := tc.standardRequest(ctx, showTweetEndpoint, auth, query, nil, &tweet)
+  endRPCSpan(span, err)
+  if err != nil {
+    t.Fatalf("request: %v", err)
+  }
+
+  names := make(map[string]bool)
+  for _, span := range recorder.Ended() {
+    names[span.Name()] = true
+    if got := span.SpanContext().TraceID().String(); got != traceID {
+      t.Errorf("span %q has trace id %s, expected %s", span.Name(), got, traceID)
+    }
+  }
+  for _, name := range []string{"/Twitter/GetTweet", "rate_limit.use", "oauth.sign", "twitter " + showTweetEndpoint.path} {
+    if !names[name] {
+      t.Errorf("no span named %q", name)
+    }
+  }
+}
@@ -1,13 +1,24 @@
 package proxy
 
 import (
+  "context"
+  "crypto/sha256"
+  "encoding/hex"
   "encoding/json"
-  "fmt"
+  "errors"
   "github.com/pantonshire/goldcrest/proxy/oauth"
+  "github.com/sirupsen/logrus"
+  "go.opentelemetry.io/otel/attribute"
+  "go.opentelemetry.io/otel/trace"
+  "go.opentelemetry.io/otel/trace/noop"
+  "golang.org/x/sync/singleflight"
   "io/ioutil"
   "math/bits"
   "net/http"
+  "net/http/httptrace"
   "strconv"
+  "strings"
+  "sync/atomic"
   "time"
 )
 
@@ -32,6 +43,8 @@ type limitGroup string
 
 const (
   publishLimitGroup = "publish"
+  mediaLimitGroup   = "media"
+  followLimitGroup  = "follow"
 )
 
 type requestMethod string
@@ -49,22 +62,50 @@ type endpoint struct {
   path   string
   method requestMethod
   group  limitGroup
+  // Whether the endpoint is served from the upload base URL rather than the usual one
+  upload bool
+  // Whether the path was chosen by a client of the proxy, through GetRaw
+  raw bool
 }
 
 var (
-  showTweetEndpoint       = endpoint{path: "statuses/show.json", method: methodGet}
-  showTweetsEndpoint      = endpoint{path: "statuses/lookup.json", method: methodGet}
-  homeTimelineEndpoint    = endpoint{path: "statuses/home_timeline.json", method: methodGet}
-  mentionTimelineEndpoint = endpoint{path: "statuses/mentions_timeline.json", method: methodGet}
-  userTimelineEndpoint    = endpoint{path: "statuses/user_timeline.json", method: methodGet}
-  publishTweetEndpoint    = endpoint{path: "statuses/update.json", method: methodPost, group: publishLimitGroup}
-  destroyTweetEndpoint    = endpoint{path: "statuses/destroy.json", method: methodPost}
-  retweetEndpoint         = endpoint{path: "statuses/retweet.json", method: methodPost, group: publishLimitGroup}
-  unretweetEndpoint       = endpoint{path: "statuses/unretweet.json", method: methodPost}
-  likeEndpoint            = endpoint{path: "favorites/create.json", method: methodPost}
-  unlikeEndpoint          = endpoint{path: "favorites/destroy.json", method: methodPost}
-  searchEndpoint          = endpoint{path: "search/tweets.json", method: methodGet}
-  updateProfileEndpoint   = endpoint{path: "account/update_profile.json", method: methodPost}
+  showTweetEndpoint        = endpoint{path: "statuses/show.json", method: methodGet}
+  showTweetsEndpoint       = endpoint{path: "statuses/lookup.json", method: methodGet}
+  homeTimelineEndpoint     = endpoint{path: "statuses/home_timeline.json", method: methodGet}
+  mentionTimelineEndpoint  = endpoint{path: "statuses/mentions_timeline.json", method: methodGet}
+  userTimelineEndpoint     = endpoint{path: "statuses/user_timeline.json", method: methodGet}
+  publishTweetEndpoint     = endpoint{path: "statuses/update.json", method: methodPost, group: publishLimitGroup}
+  destroyTweetEndpoint     = endpoint{path: "statuses/destroy.json", method: methodPost}
+  retweetEndpoint          = endpoint{path: "statuses/retweet.json", method: methodPost, group: publishLimitGroup}
+  unretweetEndpoint        = endpoint{path: "statuses/unretweet.json", method: methodPost}
+  likeEndpoint             = endpoint{path: "favorites/create.json", method: methodPost}
+  unlikeEndpoint           = endpoint{path: "favorites/destroy.json", method: methodPost}
+  searchEndpoint           = endpoint{path: "search/tweets.json", method: methodGet}
+  updateProfileEndpoint    = endpoint{path: "account/update_profile.json", method: methodPost}
+  showUserEndpoint         = endpoint{path: "users/show.json", method: methodGet}
+  showUsersEndpoint        = endpoint{path: "users/lookup.json", method: methodGet}
+  followerIDsEndpoint      = endpoint{path: "followers/ids.json", method: methodGet}
+  followingIDsEndpoint     = endpoint{path: "friends/ids.json", method: methodGet}
+  followersEndpoint        = endpoint{path: "followers/list.json", method: methodGet}
+  followingEndpoint        = endpoint{path: "friends/list.json", method: methodGet}
+  followEndpoint           = endpoint{path: "friendships/create.json", method: methodPost, group: followLimitGroup}
+  unfollowEndpoint         = endpoint{path: "friendships/destroy.json", method: methodPost}
+  showFriendshipEndpoint   = endpoint{path: "friendships/show.json", method: methodGet}
+  blockEndpoint            = endpoint{path: "blocks/create.json", method: methodPost}
+  unblockEndpoint          = endpoint{path: "blocks/destroy.json", method: methodPost}
+  muteEndpoint             = endpoint{path: "mutes/users/create.json", method: methodPost}
+  unmuteEndpoint           = endpoint{path: "mutes/users/destroy.json", method: methodPost}
+  sendDMEndpoint           = endpoint{path: "direct_messages/events/new.json", method: methodPost}
+  listDMsEndpoint          = endpoint{path: "direct_messages/events/list.json", method: methodGet}
+  showListEndpoint         = endpoint{path: "lists/show.json", method: methodGet}
+  listTimelineEndpoint     = endpoint{path: "lists/statuses.json", method: methodGet}
+  listMembersEndpoint      = endpoint{path: "lists/members.json", method: methodGet}
+  addListMemberEndpoint    = endpoint{path: "lists/members/create.json", method: methodPost}
+  removeListMemberEndpoint = endpoint{path: "lists/members/destroy.json", method: methodPost}
+  ownedListsEndpoint       = endpoint{path: "lists/ownerships.json", method: methodGet}
+  rateLimitStatusEndpoint  = endpoint{path: "application/rate_limit_status.json", method: methodGet}
+  mediaUploadEndpoint      = endpoint{path: "media/upload.json", method: methodPost, group: mediaLimitGroup, upload: true}
+  mediaStatusEndpoint      = endpoint{path: "media/upload.json", method: methodGet, upload: true}
 )
 
 func (ep endpoint) limitKey() string {
@@ -74,111 +115,293 @@ func (ep endpoint) limitKey() string {
   return "singleton:" + ep.path
 }
 
+// Returns the label the endpoint is given in metrics. Paths chosen by clients are all labelled "raw", so that
+// they cannot add any number of series.
+func (ep endpoint) metricLabel() string {
+  if ep.raw {
+    return "raw"
+  }
+  return ep.path
+}
+
+// Returns the name used to refer to the endpoint's rate limit in configuration, which is the name of its
+// group if it has one and its path otherwise.
+func (ep endpoint) limitName() string {
+  if ep.group != "" {
+    return string(ep.group)
+  }
+  return ep.path
+}
+
 type twitterClient struct {
-  client        *http.Client
-  ses           *sessions
-  protocol, url string
+  client                   *http.Client
+  ses                      *sessions
+  protocol, url, uploadURL string
+  // Nil if response caching is disabled
+  cache    *responseCache
+  inflight *singleflight.Group
+  // The number of units of each rate limit, keyed by limitName, which background requests may not use
+  reserves map[string]uint
+  // Nil if no clients have quotas
+  quotas *quotas
+  // Whether to fetch the rate limits for each access token from Twitter before its first request
+  warmUp  bool
+  metrics *metrics
+  tracer  trace.Tracer
+  retry   retryPolicy
+  // Used for logging outside of RPCs; see logger
+  log logrus.FieldLogger
 }
 
-func newTwitterClient(timeout time.Duration, protocol, url string, assumeNextLimit bool) twitterClient {
+func newTwitterClient(timeout time.Duration, protocol, url, uploadURL string, newLimit limitFactory) twitterClient {
   client := http.Client{
     Timeout: timeout,
   }
   return twitterClient{
-    client:   &client,
-    ses:      newSessions(assumeNextLimit),
-    protocol: protocol,
-    url:      url,
+    client:    &client,
+    ses:       newSessions(newLimit),
+    protocol:  protocol,
+    url:       url,
+    uploadURL: uploadURL,
+    inflight:  new(singleflight.Group),
+    tracer:    noop.NewTracerProvider().Tracer(tracerName),
+    log:       discardLogger(),
   }
 }
 
-func (tc twitterClient) standardRequest(ep endpoint, auth oauth.AuthPair, query, body oauth.Params, output interface{}) error {
-  return tc.oauthRequest(ep, auth, query, body, func(resp *http.Response) error {
-    return json.NewDecoder(resp.Body).Decode(output)
-  })
+// Returns how long to wait before the next request to the endpoint using the given token, so that the
+// requests remaining in the current rate limit window are spread evenly across it.
+func (tc twitterClient) pacing(ep endpoint, token string) time.Duration {
+  return tc.ses.get(token).getLimit(ep.limitKey()).pacing(time.Now())
 }
 
-func (tc twitterClient) oauthRequest(ep endpoint, auth oauth.AuthPair, query, body oauth.Params, handler func(resp *http.Response) error) error {
-  oauthReq := oauth.NewRequest(ep.method.String(), tc.protocol, tc.url, ep.path, query, body)
-  req, err := oauthReq.MakeRequest(auth)
+func (tc twitterClient) standardRequest(ctx context.Context, ep endpoint, auth oauth.AuthPair, query, body oauth.Params, output interface{}) error {
+  if ep.method != methodGet {
+    return tc.oauthRequest(ctx, ep, auth, query, body, decodeJSON(output))
+  }
+  data, err := tc.fetchBody(ctx, ep, auth, query, body)
   if err != nil {
     return err
   }
-  return tc.request(req, ep, auth.Public.Token, handler)
+  return json.Unmarshal(data, output)
 }
 
-func (tc twitterClient) request(req *http.Request, ep endpoint, token string, handler func(resp *http.Response) error) (err error) {
-  resp, err := func() (*http.Response, error) {
-    rl := tc.ses.get(token).getLimit(ep.limitKey())
+// Makes the request and returns the response body. If an identical request with the same credentials is
+// already in flight, its response is shared rather than making another request, so only one unit of the
+// rate limit is used. The shared request carries on if the caller which started it goes away, and each
+// caller is charged its own quota and told about any retries separately.
+func (tc twitterClient) fetchBody(ctx context.Context, ep endpoint, auth oauth.AuthPair, query, body oauth.Params) ([]byte, error) {
+  refund, err := tc.quotas.use(ctx, ep)
+  if err != nil {
+    return nil, err
+  }
+  key := sharingKey(ctx, requestKey(ep, auth, query))
+  for {
+    flight := tc.inflight.DoChan(key, func() (interface{}, error) {
+      flightCtx, cancel := detachContext(ctx)
+      defer cancel()
+      var fetched fetchedBody
+      var err error
+      fetched.sendResult, err = tc.send(flightCtx, func() (*http.Request, error) {
+        return tc.makeRequest(flightCtx, ep, auth, query, body)
+      }, ep, auth, checkStatus(func(resp *http.Response) error {
+        var err error
+        fetched.body, err = ioutil.ReadAll(resp.Body)
+        return err
+      }))
+      return fetched, err
+    })
+    select {
+    case <-ctx.Done():
+      return nil, ctx.Err()
+    case res := <-flight:
+      fetched := res.Val.(fetchedBody)
+      if fetched.retries > 0 {
+        tc.reportRetries(ctx, ep, fetched.retries)
+      }
+      if res.Err != nil {
+        // The shared request ran out of the time the caller which started it had, so make it again
+        if res.Shared && ctx.Err() == nil && errors.Is(res.Err, context.DeadlineExceeded) {
+          continue
+        }
+        if !fetched.sent {
+          refund()
+        }
+        return nil, res.Err
+      }
+      return fetched.body, nil
+    }
+  }
+}
 
-    var (
-      limitCurrent, limitNext *uint
-      limitResets             *time.Time
-      rateLimitHit            bool
-    )
+type fetchedBody struct {
+  body []byte
+  sendResult
+}
 
-    if err := rl.use(); err != nil {
-      return nil, err
-    }
+// Returns a context with the values of ctx, such as its logger, trace span and request metadata, which is not
+// cancelled when ctx is. It has the same deadline as ctx, so that it still gives up eventually.
+func detachContext(ctx context.Context) (context.Context, context.CancelFunc) {
+  detached := context.WithoutCancel(ctx)
+  if deadline, ok := ctx.Deadline(); ok {
+    return context.WithDeadline(detached, deadline)
+  }
+  return context.WithCancel(detached)
+}
 
-    defer func() {
-      rl.finish(limitCurrent, limitNext, limitResets, rateLimitHit)
-    }()
+// Identifies a request for caching and coalescing. Responses can depend on who is asking (e.g. whether the
+// authenticated user has liked a tweet), so the credentials are part of the key; the secrets are hashed so
+// that they are not kept in memory any longer than necessary.
+func requestKey(ep endpoint, auth oauth.AuthPair, query oauth.Params) string {
+  secrets := sha256.Sum256([]byte(auth.Secret.Key + "&" + auth.Secret.Token))
+  return strings.Join([]string{
+    auth.Public.Key,
+    auth.Public.Token,
+    hex.EncodeToString(secrets[:]),
+    ep.method.String(),
+    ep.path + "?" + query.Encode(),
+  }, " ")
+}
 
-    resp, err := tc.client.Do(req)
-    if err != nil {
-      return nil, err //TODO: replace with custom error for connection failed
-    }
+// Adds how the caller wants the request to be rate limited to its key, so that a request is only shared
+// between callers with the same priority and wait mode. Otherwise, for example, an interactive caller could
+// be refused because the request it joined was made in the background.
+func sharingKey(ctx context.Context, key string) string {
+  return key + " " + strconv.Itoa(int(requestPriority(ctx))) + " " + strconv.FormatBool(waitForLimit(ctx))
+}
+
+func decodeJSON(output interface{}) func(resp *http.Response) error {
+  return func(resp *http.Response) error {
+    return json.NewDecoder(resp.Body).Decode(output)
+  }
+}
 
-    tooManyRequests := resp.StatusCode == http.StatusTooManyRequests
+func discardBody(*http.Response) error {
+  return nil
+}
 
-    var headerParseErr error
+func (tc twitterClient) oauthRequest(ctx context.Context, ep endpoint, auth oauth.AuthPair, query, body oauth.Params, handler func(resp *http.Response) error) error {
+  return tc.request(ctx, func() (*http.Request, error) {
+    return tc.makeRequest(ctx, ep, auth, query, body)
+  }, ep, auth, handler)
+}
 
-    if val, ok, err := parseLimitHeader(resp.Header.Get(headerRateLimitRemaining)); ok && err == nil {
-      if !tooManyRequests {
-        limitCurrent = new(uint)
-        *limitCurrent = val
-      }
-    } else if err != nil {
-      headerParseErr = err
-    }
+// Like oauthRequest, but the handler is called for any response that does not indicate a rate limit error,
+// regardless of its status code.
+func (tc twitterClient) rawOAuthRequest(ctx context.Context, ep endpoint, auth oauth.AuthPair, query, body oauth.Params, handler func(resp *http.Response) error) error {
+  return tc.rawRequest(ctx, func() (*http.Request, error) {
+    return tc.makeRequest(ctx, ep, auth, query, body)
+  }, ep, auth, handler)
+}
 
-    if val, ok, err := parseLimitHeader(resp.Header.Get(headerRateLimit)); ok && err == nil {
-      limitNext = new(uint)
-      *limitNext = val
-    } else if err != nil {
-      headerParseErr = err
-    }
+// Encodes the body as JSON, which is required by the direct message endpoints. The response is decoded into
+// output.
+func (tc twitterClient) jsonRequest(ctx context.Context, ep endpoint, auth oauth.AuthPair, query oauth.Params, body, output interface{}) error {
+  encoded, err := json.Marshal(body)
+  if err != nil {
+    return err
+  }
+  return tc.request(ctx, func() (*http.Request, error) {
+    oauthReq := oauth.NewJSONRequest(ep.method.String(), tc.protocol, tc.baseURL(ep), ep.path, query, encoded)
+    return tc.sign(ctx, oauthReq, auth)
+  }, ep, auth, decodeJSON(output))
+}
 
-    if val, ok, err := parseLimitResetsHeader(resp.Header.Get(headerRateLimitReset)); ok && err == nil {
-      limitResets = new(time.Time)
-      *limitResets = val
-    } else if err != nil {
-      headerParseErr = err
-    }
+// Sends the body as multipart/form-data, which is required for uploading binary data.
+func (tc twitterClient) multipartRequest(ctx context.Context, ep endpoint, auth oauth.AuthPair, query, body oauth.Params, files []oauth.File, handler func(resp *http.Response) error) error {
+  return tc.request(ctx, func() (*http.Request, error) {
+    oauthReq := oauth.NewMultipartRequest(ep.method.String(), tc.protocol, tc.baseURL(ep), ep.path, query, body, files)
+    return tc.sign(ctx, oauthReq, auth)
+  }, ep, auth, handler)
+}
 
-    //TODO: need to test this (with one of the POST endpoints, probably)
-    if tooManyRequests {
-      log.Info("429 too many requests")
+func (tc twitterClient) makeRequest(ctx context.Context, ep endpoint, auth oauth.AuthPair, query, body oauth.Params) (*http.Request, error) {
+  oauthReq := oauth.NewRequest(ep.method.String(), tc.protocol, tc.baseURL(ep), ep.path, query, body)
+  return tc.sign(ctx, oauthReq, auth)
+}
 
-      rateLimitHit = true
+func (tc twitterClient) sign(ctx context.Context, oauthReq oauth.Request, auth oauth.AuthPair) (req *http.Request, err error) {
+  _, span := tc.tracer.Start(ctx, "oauth.sign")
+  defer func() {
+    endSpan(span, err)
+  }()
+  return oauthReq.MakeRequest(auth)
+}
 
-      limitCurrent = new(uint)
-      *limitCurrent = 0
+func (tc twitterClient) baseURL(ep endpoint) string {
+  if ep.upload {
+    return tc.uploadURL
+  }
+  return tc.url
+}
 
-      if limitResets != nil {
-        return resp, newRateLimitError(*limitResets)
-      } else {
-        return resp, newRateLimitError(time.Time{})
-      }
-    }
+// Sends the request made by newReq, which is called again to make a freshly signed copy of the request each
+// time it is retried.
+func (tc twitterClient) request(ctx context.Context, newReq func() (*http.Request, error), ep endpoint, auth oauth.AuthPair, handler func(resp *http.Response) error) error {
+  return tc.rawRequest(ctx, newReq, ep, auth, checkStatus(handler))
+}
 
-    if headerParseErr != nil {
-      return resp, newBadResponseError("Twitter responded with a rate limit header that could not be parsed")
+// Wraps handler so that it is only called for successful responses. Other responses are turned into errors.
+func checkStatus(handler func(resp *http.Response) error) func(resp *http.Response) error {
+  return func(resp *http.Response) error {
+    if 200 <= resp.StatusCode && resp.StatusCode < 300 {
+      return handler(resp)
     }
+    body, err := ioutil.ReadAll(resp.Body)
+    if err != nil {
+      return err
+    }
+    return newAPIError(resp.StatusCode, resp.Status, body)
+  }
+}
 
-    return resp, nil
-  }()
+// Charges the request to the quota of the client which made it, then sends it. The charge is refunded if the
+// request never reached Twitter, e.g. because it was refused by the rate limit.
+func (tc twitterClient) rawRequest(ctx context.Context, newReq func() (*http.Request, error), ep endpoint, auth oauth.AuthPair, handler func(resp *http.Response) error) error {
+  refund, err := tc.quotas.use(ctx, ep)
+  if err != nil {
+    return err
+  }
+  sr, err := tc.send(ctx, newReq, ep, auth, handler)
+  if err != nil && !sr.sent {
+    refund()
+  }
+  if sr.retries > 0 {
+    tc.reportRetries(ctx, ep, sr.retries)
+  }
+  return err
+}
+
+// What happened while sending a request, apart from the response itself.
+type sendResult struct {
+  // Whether the rate limit let any attempt at the request through, so that Twitter may have received it
+  sent    bool
+  retries uint
+}
+
+// Sends the request, retrying it if the retry policy allows, and passes the response to handler. Any rate
+// limit error is returned without calling handler.
+func (tc twitterClient) send(ctx context.Context, newReq func() (*http.Request, error), ep endpoint, auth oauth.AuthPair, handler func(resp *http.Response) error) (sr sendResult, err error) {
+  var resp *http.Response
+  for ; ; sr.retries++ {
+    var sent bool
+    resp, sent, err = tc.attempt(ctx, newReq, ep, auth)
+    sr.sent = sr.sent || sent
+    delay, ok := tc.retry.next(ctx, sr.retries, ep, resp, err)
+    if !ok {
+      break
+    }
+    log := tc.logger(ctx).WithField("endpoint", ep.path).WithField("delay", delay)
+    if resp != nil {
+      log.WithField("status", resp.Status).Info("Retrying request to Twitter")
+      resp.Body.Close()
+    } else {
+      log.WithError(err).Info("Retrying request to Twitter")
+    }
+    if err := sleepContext(ctx, delay); err != nil {
+      return sr, err
+    }
+  }
 
   if resp != nil {
     defer func() {
@@ -189,23 +412,130 @@ func (tc twitterClient) request(req *http.Request, ep endpoint, token string, ha
   }
 
   if err != nil {
-    return err
+    if _, ok := err.(rateLimitError); ok {
+      tc.metrics.observeRateLimitError(ep)
+    }
+    return sr, err
   }
 
-  if 200 <= resp.StatusCode && resp.StatusCode < 300 {
-    return handler(resp)
-  } else {
-    body, err := ioutil.ReadAll(resp.Body)
-    if err != nil {
-      return err
+  return sr, handler(resp)
+}
+
+// Makes a single attempt at the request, taking a unit of the rate limit for it. Reports whether the rate limit
+// let the attempt through.
+func (tc twitterClient) attempt(ctx context.Context, newReq func() (*http.Request, error), ep endpoint, auth oauth.AuthPair) (*http.Response, bool, error) {
+  req, err := newReq()
+  if err != nil {
+    return nil, false, err
+  }
+
+  rl := tc.session(ctx, auth).endpointLimit(ep)
+
+  var (
+    limitCurrent, limitNext *uint
+    limitResets             *time.Time
+    rateLimitHit            bool
+  )
+
+  if err := tc.useLimit(ctx, ep, rl); err != nil {
+    return nil, false, err
+  }
+
+  defer func() {
+    rl.finish(tc.logger(ctx), limitCurrent, limitNext, limitResets, rateLimitHit)
+  }()
+
+  httpCtx, span := tc.tracer.Start(ctx, "twitter "+ep.path, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+    attribute.String("http.request.method", ep.method.String()),
+    attribute.String("url.path", ep.path),
+  ))
+  // Records whether any of the request was written to the connection, since it is only safe to retry
+  // requests which are not idempotent if Twitter cannot have received them
+  var sent atomic.Bool
+  httpCtx = httptrace.WithClientTrace(httpCtx, &httptrace.ClientTrace{
+    WroteHeaderField: func(string, []string) {
+      sent.Store(true)
+    },
+  })
+  start := time.Now()
+  resp, err := tc.client.Do(req.WithContext(httpCtx))
+  tc.metrics.observeTwitterRequest(ep, resp, time.Since(start))
+  if resp != nil {
+    span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+  }
+  endSpan(span, err)
+  if err != nil {
+    if ctx.Err() != nil {
+      return nil, true, err
     }
-    msg := fmt.Sprintf("Twitter responded with %s: %s", resp.Status, string(body))
-    if 400 <= resp.StatusCode && resp.StatusCode < 500 {
-      return newBadRequestError(msg)
+    return nil, true, newConnectionError(err, sent.Load())
+  }
+
+  tooManyRequests := resp.StatusCode == http.StatusTooManyRequests
+
+  var headerParseErr error
+
+  if val, ok, err := parseLimitHeader(resp.Header.Get(headerRateLimitRemaining)); ok && err == nil {
+    if !tooManyRequests {
+      limitCurrent = new(uint)
+      *limitCurrent = val
+    }
+  } else if err != nil {
+    headerParseErr = err
+  }
+
+  if val, ok, err := parseLimitHeader(resp.Header.Get(headerRateLimit)); ok && err == nil {
+    limitNext = new(uint)
+    *limitNext = val
+  } else if err != nil {
+    headerParseErr = err
+  }
+
+  if val, ok, err := parseLimitResetsHeader(resp.Header.Get(headerRateLimitReset)); ok && err == nil {
+    limitResets = new(time.Time)
+    *limitResets = val
+  } else if err != nil {
+    headerParseErr = err
+  }
+
+  //TODO: need to test this (with one of the POST endpoints, probably)
+  if tooManyRequests {
+    tc.logger(ctx).WithField("endpoint", ep.path).Info("429 too many requests")
+
+    rateLimitHit = true
+
+    limitCurrent = new(uint)
+    *limitCurrent = 0
+
+    if limitResets != nil {
+      return resp, true, newRateLimitError(*limitResets)
     } else {
-      return newTwitterError(msg)
+      return resp, true, newRateLimitError(time.Time{})
     }
   }
+
+  if headerParseErr != nil {
+    return resp, true, newBadResponseError("Twitter responded with a rate limit header that could not be parsed")
+  }
+
+  return resp, true, nil
+}
+
+// Takes a unit of the rate limit, waiting for it to reset first if the client asked to.
+func (tc twitterClient) useLimit(ctx context.Context, ep endpoint, rl *queuedLimit) (err error) {
+  ctx, span := tc.tracer.Start(ctx, "rate_limit.use", trace.WithAttributes(
+    attribute.String("goldcrest.limit_key", ep.limitKey()),
+  ))
+  defer func() {
+    endSpan(span, err)
+  }()
+
+  log := tc.logger(ctx).WithField("limit", ep.limitKey())
+  reserve := tc.reserve(ctx, ep)
+  if waitForLimit(ctx) {
+    return rl.wait(ctx, log, reserve)
+  }
+  return rl.try(ctx, log, reserve)
 }
 
 func parseLimitHeader(s string) (uint, bool, error) {
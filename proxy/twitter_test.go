@@ -0,0 +1,106 @@
+package proxy
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+  "net/http/httptest"
+  "sync"
+  "sync/atomic"
+  "testing"
+  "time"
+)
+
+func TestCoalescedRequests(t *testing.T) {
+  var requests int32
+  release := make(chan struct{})
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    atomic.AddInt32(&requests, 1)
+    <-release
+    w.Header().Set(headerRateLimit, "900")
+    w.Header().Set(headerRateLimitRemaining, "899")
+    w.Header().Set(headerRateLimitReset, fmt.Sprint(time.Now().Add(time.Minute).Unix()))
+    fmt.Fprint(w, `{"id": 123}`)
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  auth, query := reserTweetRequest(nil)
+
+  const workers = 20
+  var wg sync.WaitGroup
+  ids := make([]uint64, workers)
+  errs := make([]error, workers)
+  for i := 0; i < workers; i++ {
+    wg.Add(1)
+    go func(i int) {
+      defer wg.Done()
+      var tweet struct {
+        ID uint64 `json:"id"`
+      }
+      errs[i] = tc.standardRequest(context.Background(), showTweetEndpoint, auth, query, nil, &tweet)
+      ids[i] = tweet.ID
+    }(i)
+  }
+  // Give every worker time to join the in-flight request before it completes
+  time.Sleep(100 * time.Millisecond)
+  close(release)
+  wg.Wait()
+
+  for i := 0; i < workers; i++ {
+    if errs[i] != nil {
+      t.Fatalf("worker %d: %v", i, errs[i])
+    }
+    if ids[i] != 123 {
+      t.Errorf("worker %d got id %d, expected 123", i, ids[i])
+    }
+  }
+  if requests != 1 {
+    t.Errorf("got %d upstream requests, expected 1", requests)
+  }
+  snapshot, _ := tc.ses.get("").getLimit(showTweetEndpoint.limitKey()).snapshot()
+  if snapshot.Current == nil || *snapshot.Current != 899 {
+    t.Errorf("got remaining limit %v, expected 899", snapshot.Current)
+  }
+}
+
+func TestCoalescedRequestOutlivesCaller(t *testing.T) {
+  started := make(chan struct{})
+  release := make(chan struct{})
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    close(started)
+    <-release
+    fmt.Fprint(w, `{"id": 123}`)
+  }))
+  defer server.Close()
+
+  tc := newTwitterClient(time.Second, "http", server.Listener.Addr().String(), "", localLimits(true))
+  auth, query := reserTweetRequest(nil)
+  var tweet struct {
+    ID uint64 `json:"id"`
+  }
+
+  firstCtx, cancel := context.WithCancel(context.Background())
+  first := make(chan error, 1)
+  go func() {
+    first <- tc.standardRequest(firstCtx, showTweetEndpoint, auth, query, nil, &struct{}{})
+  }()
+  <-started
+  second := make(chan error, 1)
+  go func() {
+    second <- tc.standardRequest(context.Background(), showTweetEndpoint, auth, query, nil, &tweet)
+  }()
+  // Give the second caller time to join the in-flight request before the first goes away
+  time.Sleep(50 * time.Millisecond)
+  cancel()
+  if err := <-first; err != context.Canceled {
+    t.Errorf("first caller got %v, expected %v", err, context.Canceled)
+  }
+  close(release)
+  if err := <-second; err != nil {
+    t.Fatalf("second caller: %v", err)
+  }
+  if tweet.ID != 123 {
+    t.Errorf("got id %d, expected 123", tweet.ID)
+  }
+}
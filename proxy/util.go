@@ -1,5 +1,10 @@
 package proxy
 
+import (
+  "context"
+  "time"
+)
+
 // Returns the first non-empty string provided.
 // If all strings are empty, an empty string is returned.
 func strAlt(str string, strs ...string) string {
@@ -20,3 +25,15 @@ func strSafeDeref(str *string) string {
   }
   return *str
 }
+
+// Blocks until the given duration has elapsed or the context is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+  timer := time.NewTimer(d)
+  defer timer.Stop()
+  select {
+  case <-ctx.Done():
+    return ctx.Err()
+  case <-timer.C:
+    return nil
+  }
+}